@@ -0,0 +1,50 @@
+package auth0
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// datasourceSchemaFromResourceSchema converts the schema of a resource into
+// the schema of a data source. All attributes become computed, and any
+// settings which only make sense for configurable attributes (defaults,
+// validation, diff suppression etc.) are dropped.
+func datasourceSchemaFromResourceSchema(rs map[string]*schema.Schema) map[string]*schema.Schema {
+	ds := make(map[string]*schema.Schema, len(rs))
+	for k, v := range rs {
+		if v.Removed != "" {
+			continue
+		}
+
+		dv := &schema.Schema{
+			Type:        v.Type,
+			Computed:    true,
+			Sensitive:   v.Sensitive,
+			Description: v.Description,
+			Deprecated:  v.Deprecated,
+			ConfigMode:  v.ConfigMode,
+		}
+
+		switch elem := v.Elem.(type) {
+		case *schema.Resource:
+			dv.Elem = &schema.Resource{
+				Schema: datasourceSchemaFromResourceSchema(elem.Schema),
+			}
+		case *schema.Schema:
+			dv.Elem = &schema.Schema{Type: elem.Type}
+		default:
+			dv.Elem = v.Elem
+		}
+
+		ds[k] = dv
+	}
+	return ds
+}
+
+// addOptionalFieldsToSchema marks the given keys of a data source schema as
+// optional, so they can be used as arguments to look up the data source.
+func addOptionalFieldsToSchema(s map[string]*schema.Schema, keys ...string) {
+	for _, k := range keys {
+		s[k].Computed = true
+		s[k].Optional = true
+	}
+}
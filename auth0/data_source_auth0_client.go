@@ -0,0 +1,77 @@
+package auth0
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"gopkg.in/auth0.v5/management"
+)
+
+func newDataClient() *schema.Resource {
+	return &schema.Resource{
+		Read:   readDataClient,
+		Schema: newDataClientSchema(),
+	}
+}
+
+func newDataClientSchema() map[string]*schema.Schema {
+	s := datasourceSchemaFromResourceSchema(newClient().Schema)
+	addOptionalFieldsToSchema(s, "name", "client_id")
+	s["name"].ExactlyOneOf = []string{"name", "client_id"}
+	s["client_id"].ExactlyOneOf = []string{"name", "client_id"}
+	return s
+}
+
+func readDataClient(d *schema.ResourceData, m interface{}) error {
+	id := d.Get("client_id").(string)
+	if name := d.Get("name").(string); name != "" {
+		api := m.(*management.Management)
+		c, err := findClientByName(api, name)
+		if err != nil {
+			return err
+		}
+		id = c.GetClientID()
+	}
+
+	d.SetId(id)
+	if err := readClient(d, m); err != nil {
+		return err
+	}
+	if d.Id() == "" {
+		return fmt.Errorf("no client found with client_id %q", id)
+	}
+	return nil
+}
+
+// findClientByName pages through all clients of the tenant and returns the
+// one with the given name. It fails if no client, or more than one client,
+// matches the name.
+func findClientByName(api *management.Management, name string) (*management.Client, error) {
+	var matches []*management.Client
+	var page int
+	for {
+		l, err := api.Client.List(management.Page(page))
+		if err != nil {
+			return nil, err
+		}
+		for _, client := range l.Clients {
+			if client.GetName() == name {
+				matches = append(matches, client)
+			}
+		}
+		if !l.HasNext() {
+			break
+		}
+		page++
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no client found with name %q", name)
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, fmt.Errorf("found %d clients with name %q, use client_id to select one of them", len(matches), name)
+	}
+}
@@ -0,0 +1,63 @@
+package auth0
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/alexkappa/terraform-provider-auth0/auth0/internal/random"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccDataClient(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(testAccDataClientConfig, rand),
+				Check: resource.ComposeTestCheckFunc(
+					random.TestCheckResourceAttr("data.auth0_client.by_name", "name", "Acceptance Test - Data Client - {{.random}}", rand),
+					resource.TestCheckResourceAttrPair("data.auth0_client.by_name", "client_id", "auth0_client.my_client", "client_id"),
+					resource.TestCheckResourceAttr("data.auth0_client.by_name", "app_type", "non_interactive"),
+					resource.TestCheckResourceAttr("data.auth0_client.by_name", "callbacks.#", "1"),
+					resource.TestCheckResourceAttrPair("data.auth0_client.by_id", "name", "auth0_client.my_client", "name"),
+					resource.TestCheckResourceAttr("data.auth0_client.by_id", "description", "Test Application Long Description"),
+				),
+			},
+			{
+				Config:      random.Template(testAccDataClientConfigNotFound, rand),
+				ExpectError: regexp.MustCompile(`no client found with name`),
+			},
+		},
+	})
+}
+
+const testAccDataClientConfig = `
+
+resource "auth0_client" "my_client" {
+  name = "Acceptance Test - Data Client - {{.random}}"
+  description = "Test Application Long Description"
+  app_type = "non_interactive"
+  callbacks = [ "https://example.com/callback" ]
+}
+
+data "auth0_client" "by_name" {
+  name = auth0_client.my_client.name
+}
+
+data "auth0_client" "by_id" {
+  client_id = auth0_client.my_client.client_id
+}
+`
+
+const testAccDataClientConfigNotFound = `
+
+data "auth0_client" "missing" {
+  name = "Acceptance Test - Data Client - {{.random}} - Missing"
+}
+`
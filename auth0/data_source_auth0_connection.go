@@ -0,0 +1,58 @@
+package auth0
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"gopkg.in/auth0.v5/management"
+)
+
+func newDataConnection() *schema.Resource {
+	return &schema.Resource{
+		Read:   readDataConnection,
+		Schema: newDataConnectionSchema(),
+	}
+}
+
+func newDataConnectionSchema() map[string]*schema.Schema {
+	s := datasourceSchemaFromResourceSchema(connectionSchema)
+	s["connection_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"connection_id", "name"},
+		Description:  "ID of the connection",
+	}
+	addOptionalFieldsToSchema(s, "name")
+	s["name"].ExactlyOneOf = []string{"connection_id", "name"}
+	return s
+}
+
+func readDataConnection(d *schema.ResourceData, m interface{}) error {
+	id := d.Get("connection_id").(string)
+	if name := d.Get("name").(string); name != "" {
+		api := m.(*management.Management)
+		c, err := api.Connection.ReadByName(name)
+		if err != nil {
+			if mErr, ok := err.(management.Error); ok {
+				if mErr.Status() == http.StatusNotFound {
+					return fmt.Errorf("no connection found with name %q", name)
+				}
+			}
+			return err
+		}
+		id = c.GetID()
+	}
+
+	d.SetId(id)
+	if err := readConnection(d, m); err != nil {
+		return err
+	}
+	if d.Id() == "" {
+		return fmt.Errorf("no connection found with id %q", id)
+	}
+	d.Set("connection_id", d.Id())
+	return nil
+}
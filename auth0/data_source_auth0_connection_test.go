@@ -0,0 +1,70 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/alexkappa/terraform-provider-auth0/auth0/internal/random"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccDataConnection(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(testAccDataConnectionConfig, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.auth0_connection.by_name", "connection_id", "auth0_connection.my_connection", "id"),
+					resource.TestCheckResourceAttr("data.auth0_connection.by_name", "strategy", "auth0"),
+					resource.TestCheckResourceAttr("data.auth0_connection.by_name", "options.0.password_policy", "fair"),
+					resource.TestCheckResourceAttr("data.auth0_connection.by_name", "options.0.brute_force_protection", "true"),
+					resource.TestCheckResourceAttr("data.auth0_connection.by_name", "enabled_clients.#", "1"),
+					random.TestCheckResourceAttr("data.auth0_connection.by_id", "name", "Acceptance-Test-Data-Connection-{{.random}}", rand),
+					resource.TestCheckResourceAttr("data.auth0_connection.by_id", "options.0.requires_username", "true"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataConnectionConfig = `
+
+resource "auth0_client" "my_client" {
+  name = "Acceptance Test - Data Connection - {{.random}}"
+}
+
+resource "auth0_connection" "my_connection" {
+  name = "Acceptance-Test-Data-Connection-{{.random}}"
+  strategy = "auth0"
+  enabled_clients = [ auth0_client.my_client.id ]
+  options {
+    password_policy = "fair"
+    brute_force_protection = true
+    requires_username = true
+  }
+}
+
+data "auth0_connection" "by_name" {
+  name = auth0_connection.my_connection.name
+}
+
+data "auth0_connection" "by_id" {
+  connection_id = auth0_connection.my_connection.id
+}
+`
+
+func TestDataConnectionSchemaSensitive(t *testing.T) {
+	options := newDataConnectionSchema()["options"].Elem.(*schema.Resource).Schema
+	for _, k := range []string{"client_secret", "configuration", "twilio_token"} {
+		if !options[k].Sensitive {
+			t.Errorf("Expected options.%s to be sensitive", k)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package auth0
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"gopkg.in/auth0.v5/management"
+)
+
+func newDataResourceServer() *schema.Resource {
+	return &schema.Resource{
+		Read:   readDataResourceServer,
+		Schema: newDataResourceServerSchema(),
+	}
+}
+
+func newDataResourceServerSchema() map[string]*schema.Schema {
+	s := datasourceSchemaFromResourceSchema(newResourceServer().Schema)
+	s["resource_server_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"resource_server_id", "identifier"},
+	}
+	addOptionalFieldsToSchema(s, "identifier")
+	s["identifier"].ExactlyOneOf = []string{"resource_server_id", "identifier"}
+	return s
+}
+
+func readDataResourceServer(d *schema.ResourceData, m interface{}) error {
+	id := d.Get("resource_server_id").(string)
+	if identifier := d.Get("identifier").(string); identifier != "" {
+		api := m.(*management.Management)
+		s, err := findResourceServerByIdentifier(api, identifier)
+		if err != nil {
+			return err
+		}
+		if s == nil {
+			return fmt.Errorf("no resource server found with identifier %q", identifier)
+		}
+		id = s.GetID()
+	}
+
+	d.SetId(id)
+	if err := readResourceServer(d, m); err != nil {
+		return err
+	}
+	if d.Id() == "" {
+		return fmt.Errorf("no resource server found with id %q", id)
+	}
+	d.Set("resource_server_id", d.Id())
+	return nil
+}
+
+// findResourceServerByIdentifier looks up a resource server by its audience
+// identifier. Identifiers are usually URLs, which can't be passed as a path
+// segment to the API, so we page through all resource servers instead. If no
+// resource server matches, it returns nil without an error.
+func findResourceServerByIdentifier(api *management.Management, identifier string) (*management.ResourceServer, error) {
+	var match *management.ResourceServer
+	err := api.ResourceServer.Stream(func(s *management.ResourceServer) {
+		if s.GetIdentifier() == identifier {
+			match = s
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return match, nil
+}
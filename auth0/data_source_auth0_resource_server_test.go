@@ -0,0 +1,60 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/alexkappa/terraform-provider-auth0/auth0/internal/random"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccDataResourceServer(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(testAccDataResourceServerConfig, rand),
+				Check: resource.ComposeTestCheckFunc(
+					random.TestCheckResourceAttr("data.auth0_resource_server.by_identifier", "name", "Acceptance Test - Data Resource Server - {{.random}}", rand),
+					resource.TestCheckResourceAttrPair("data.auth0_resource_server.by_identifier", "resource_server_id", "auth0_resource_server.my_resource_server", "id"),
+					resource.TestCheckResourceAttr("data.auth0_resource_server.by_identifier", "signing_alg", "RS256"),
+					resource.TestCheckResourceAttr("data.auth0_resource_server.by_identifier", "token_lifetime", "7200"),
+					resource.TestCheckResourceAttr("data.auth0_resource_server.by_identifier", "scopes.#", "2"),
+					random.TestCheckResourceAttr("data.auth0_resource_server.by_id", "identifier", "https://uat.api.alexkappa.com/{{.random}}", rand),
+					resource.TestCheckResourceAttr("data.auth0_resource_server.by_id", "scopes.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataResourceServerConfig = `
+
+resource "auth0_resource_server" "my_resource_server" {
+  name = "Acceptance Test - Data Resource Server - {{.random}}"
+  identifier = "https://uat.api.alexkappa.com/{{.random}}"
+  signing_alg = "RS256"
+  token_lifetime = 7200
+  scopes {
+    value = "create:foo"
+    description = "Create foos"
+  }
+  scopes {
+    value = "create:bar"
+    description = "Create bars"
+  }
+}
+
+data "auth0_resource_server" "by_identifier" {
+  identifier = auth0_resource_server.my_resource_server.identifier
+}
+
+data "auth0_resource_server" "by_id" {
+  resource_server_id = auth0_resource_server.my_resource_server.id
+}
+`
@@ -0,0 +1,101 @@
+package auth0
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"gopkg.in/auth0.v5/management"
+)
+
+func newDataRole() *schema.Resource {
+	return &schema.Resource{
+		Read:   readDataRole,
+		Schema: newDataRoleSchema(),
+	}
+}
+
+func newDataRoleSchema() map[string]*schema.Schema {
+	s := datasourceSchemaFromResourceSchema(newRole().Schema)
+	s["role_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"role_id", "name"},
+	}
+	addOptionalFieldsToSchema(s, "name")
+	s["name"].ExactlyOneOf = []string{"role_id", "name"}
+	s["user_ids"] = &schema.Schema{
+		Type:     schema.TypeSet,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+		Computed: true,
+	}
+	return s
+}
+
+func readDataRole(d *schema.ResourceData, m interface{}) error {
+	api := m.(*management.Management)
+
+	id := d.Get("role_id").(string)
+	if name := d.Get("name").(string); name != "" {
+		r, err := findRoleByName(api, name)
+		if err != nil {
+			return err
+		}
+		id = r.GetID()
+	}
+
+	d.SetId(id)
+	if err := readRole(d, m); err != nil {
+		return err
+	}
+	if d.Id() == "" {
+		return fmt.Errorf("no role found with id %q", id)
+	}
+	d.Set("role_id", d.Id())
+
+	var userIDs []interface{}
+	var page int
+	for {
+		l, err := api.Role.Users(d.Id(), management.Page(page))
+		if err != nil {
+			return err
+		}
+		for _, user := range l.Users {
+			userIDs = append(userIDs, user.GetID())
+		}
+		if !l.HasNext() {
+			break
+		}
+		page++
+	}
+
+	d.Set("user_ids", userIDs)
+
+	return nil
+}
+
+// findRoleByName looks up a role by its exact name. The name_filter parameter
+// of the API is a case-insensitive partial match, so results are filtered
+// again client-side.
+func findRoleByName(api *management.Management, name string) (*management.Role, error) {
+	var page int
+	for {
+		l, err := api.Role.List(
+			management.Parameter("name_filter", name),
+			management.Page(page))
+		if err != nil {
+			return nil, err
+		}
+		for _, role := range l.Roles {
+			if role.GetName() == name {
+				return role, nil
+			}
+		}
+		if !l.HasNext() {
+			break
+		}
+		page++
+	}
+	return nil, fmt.Errorf("no role found with name %q", name)
+}
@@ -0,0 +1,65 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/alexkappa/terraform-provider-auth0/auth0/internal/random"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccDataRole(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(testAccDataRoleConfig, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.auth0_role.by_name", "role_id", "auth0_role.the_one", "id"),
+					resource.TestCheckResourceAttr("data.auth0_role.by_name", "description", "The One - Acceptance Test"),
+					resource.TestCheckResourceAttr("data.auth0_role.by_name", "permissions.#", "2"),
+					resource.TestCheckResourceAttr("data.auth0_role.by_name", "user_ids.#", "1"),
+					random.TestCheckResourceAttr("data.auth0_role.by_id", "name", "The One - Acceptance Test - {{.random}}", rand),
+					resource.TestCheckResourceAttr("data.auth0_role.by_id", "permissions.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataRoleConfig = testAccRoleAux + `
+
+resource auth0_role the_one {
+  name = "The One - Acceptance Test - {{.random}}"
+  description = "The One - Acceptance Test"
+  permissions {
+    name = "stop:bullets"
+    resource_server_identifier = auth0_resource_server.matrix.identifier
+  }
+  permissions {
+    name = "bring:peace"
+    resource_server_identifier = auth0_resource_server.matrix.identifier
+  }
+}
+
+resource auth0_user neo {
+  connection_name = "Username-Password-Authentication"
+  email = "neo.{{.random}}@acceptance.test.com"
+  password = "passpass$12$12"
+  roles = [ auth0_role.the_one.id ]
+}
+
+data auth0_role by_name {
+  name = auth0_role.the_one.name
+  depends_on = [ auth0_user.neo ]
+}
+
+data auth0_role by_id {
+  role_id = auth0_role.the_one.id
+}
+`
@@ -0,0 +1,143 @@
+package auth0
+
+import (
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/management"
+)
+
+// signingKey is one of the keys the tenant signs tokens with.
+type signingKey struct {
+	KID          *string `json:"kid,omitempty"`
+	Cert         *string `json:"cert,omitempty"`
+	PKCS7        *string `json:"pkcs7,omitempty"`
+	Current      *bool   `json:"current,omitempty"`
+	Next         *bool   `json:"next,omitempty"`
+	Previous     *bool   `json:"previous,omitempty"`
+	CurrentSince *string `json:"current_since,omitempty"`
+	CurrentUntil *string `json:"current_until,omitempty"`
+	Fingerprint  *string `json:"fingerprint,omitempty"`
+	Thumbprint   *string `json:"thumbprint,omitempty"`
+	Revoked      *bool   `json:"revoked,omitempty"`
+	RevokedAt    *string `json:"revoked_at,omitempty"`
+}
+
+func newDataSigningKeys() *schema.Resource {
+	return &schema.Resource{
+		Read: readDataSigningKeys,
+		Schema: map[string]*schema.Schema{
+			"signing_keys": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The key ID of the signing key",
+						},
+						"cert": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The public certificate of the signing key",
+						},
+						"pkcs7": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The public certificate of the signing key in PKCS7 format",
+						},
+						"fingerprint": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The cert fingerprint",
+						},
+						"thumbprint": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The cert thumbprint",
+						},
+						"current": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the key is the current key, used to sign tokens",
+						},
+						"next": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the key is the next key, which becomes current on rotation",
+						},
+						"previous": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the key is the previous key, which was current before the last rotation",
+						},
+						"current_since": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time the key became current",
+						},
+						"current_until": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time the key stopped being current",
+						},
+						"revoked": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the key is revoked",
+						},
+						"revoked_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time the key was revoked",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readDataSigningKeys(d *schema.ResourceData, m interface{}) error {
+	api := m.(*management.Management)
+	keys, err := readSigningKeys(api)
+	if err != nil {
+		return err
+	}
+
+	u, err := url.Parse(api.URI())
+	if err != nil {
+		return err
+	}
+	d.SetId(u.Host)
+	return d.Set("signing_keys", flattenSigningKeys(keys))
+}
+
+func readSigningKeys(api *management.Management) (keys []*signingKey, err error) {
+	err = api.Request("GET", api.URI("keys", "signing"), &keys)
+	return
+}
+
+func flattenSigningKeys(keys []*signingKey) []interface{} {
+	var v []interface{}
+	for _, k := range keys {
+		v = append(v, map[string]interface{}{
+			"kid":           auth0.StringValue(k.KID),
+			"cert":          auth0.StringValue(k.Cert),
+			"pkcs7":         auth0.StringValue(k.PKCS7),
+			"fingerprint":   auth0.StringValue(k.Fingerprint),
+			"thumbprint":    auth0.StringValue(k.Thumbprint),
+			"current":       auth0.BoolValue(k.Current),
+			"next":          auth0.BoolValue(k.Next),
+			"previous":      auth0.BoolValue(k.Previous),
+			"current_since": auth0.StringValue(k.CurrentSince),
+			"current_until": auth0.StringValue(k.CurrentUntil),
+			"revoked":       auth0.BoolValue(k.Revoked),
+			"revoked_at":    auth0.StringValue(k.RevokedAt),
+		})
+	}
+	return v
+}
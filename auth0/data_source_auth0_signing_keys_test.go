@@ -0,0 +1,33 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccDataSigningKeys(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSigningKeysConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.auth0_signing_keys.keys", "signing_keys.#"),
+					resource.TestCheckResourceAttrSet("data.auth0_signing_keys.keys", "signing_keys.0.kid"),
+					resource.TestCheckResourceAttrSet("data.auth0_signing_keys.keys", "signing_keys.0.cert"),
+					resource.TestCheckResourceAttrSet("data.auth0_signing_keys.keys", "signing_keys.0.fingerprint"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSigningKeysConfig = `
+
+data "auth0_signing_keys" "keys" {}
+`
@@ -0,0 +1,37 @@
+package auth0
+
+import (
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"gopkg.in/auth0.v5/management"
+)
+
+func newDataTenant() *schema.Resource {
+	return &schema.Resource{
+		Read:   readDataTenant,
+		Schema: newDataTenantSchema(),
+	}
+}
+
+func newDataTenantSchema() map[string]*schema.Schema {
+	s := datasourceSchemaFromResourceSchema(newTenant().Schema)
+	s["domain"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Your Auth0 domain name",
+	}
+	return s
+}
+
+func readDataTenant(d *schema.ResourceData, m interface{}) error {
+	api := m.(*management.Management)
+	u, err := url.Parse(api.URI())
+	if err != nil {
+		return err
+	}
+	d.SetId(u.Host)
+	d.Set("domain", u.Host)
+	return readTenant(d, m)
+}
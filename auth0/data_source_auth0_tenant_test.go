@@ -0,0 +1,34 @@
+package auth0
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccDataTenant(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataTenantConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.auth0_tenant.current", "domain", os.Getenv("AUTH0_DOMAIN")),
+					resource.TestCheckResourceAttrSet("data.auth0_tenant.current", "friendly_name"),
+					resource.TestCheckResourceAttrSet("data.auth0_tenant.current", "session_lifetime"),
+					resource.TestCheckResourceAttr("data.auth0_tenant.current", "flags.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataTenantConfig = `
+
+data "auth0_tenant" "current" {}
+`
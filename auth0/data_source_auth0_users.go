@@ -0,0 +1,197 @@
+package auth0
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"gopkg.in/auth0.v5/management"
+)
+
+// usersSearchLimit is the number of results past which the Management API
+// rejects requests for further pages of a search.
+const usersSearchLimit = 1000
+
+func newDataUsers() *schema.Resource {
+	return &schema.Resource{
+		Read: readDataUsers,
+		Schema: map[string]*schema.Schema{
+			"q": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Query in Lucene query string syntax",
+			},
+			"connection_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the connection the users belong to",
+			},
+			"sort": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[\w.]+:-?1$`),
+					"expected sort to be a field name followed by :1 (ascending) or :-1 (descending)",
+				),
+				Description: "Field to sort by. Use field:1 for ascending and field:-1 for descending order",
+			},
+			"per_page": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      50,
+				ValidateFunc: validation.IntBetween(1, 100),
+				Description:  "Number of users to retrieve per page",
+			},
+			"max_results": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      usersSearchLimit,
+				ValidateFunc: validation.IntBetween(1, usersSearchLimit),
+				Description:  "Maximum number of users to retrieve",
+			},
+			"include_roles": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Whether to retrieve the roles of each user. " +
+					"This takes a request for each user",
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: newDataUsersUserSchema(),
+				},
+			},
+		},
+	}
+}
+
+func newDataUsersUserSchema() map[string]*schema.Schema {
+	s := datasourceSchemaFromResourceSchema(newUser().Schema)
+	delete(s, "password")
+	delete(s, "verify_email")
+	return s
+}
+
+func readDataUsers(d *schema.ResourceData, m interface{}) error {
+	api := m.(*management.Management)
+
+	includeRoles := d.Get("include_roles").(bool)
+	if includeRoles {
+		if err := checkScopes(m, "auth0_users", []string{"read:roles"}); err != nil {
+			return err
+		}
+	}
+
+	perPage := d.Get("per_page").(int)
+	maxResults := d.Get("max_results").(int)
+
+	opts := []management.RequestOption{
+		management.PerPage(perPage),
+	}
+	q := buildUsersQuery(d.Get("q").(string), d.Get("connection_name").(string))
+	if q != "" {
+		opts = append(opts, management.Query(q))
+	}
+	sort := d.Get("sort").(string)
+	if sort != "" {
+		opts = append(opts, management.Parameter("sort", sort))
+	}
+
+	var users []interface{}
+	var page int
+	for {
+		l, err := api.User.List(append(opts, management.Page(page))...)
+		if err != nil {
+			return err
+		}
+		for _, u := range l.Users {
+			if len(users) == maxResults {
+				break
+			}
+			user, err := flattenDataUser(api, u, includeRoles)
+			if err != nil {
+				return err
+			}
+			users = append(users, user)
+		}
+		if !l.HasNext() || !hasNextUsersPage(page, perPage, len(users), maxResults) {
+			break
+		}
+		page++
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(q + sort)))
+	return d.Set("users", users)
+}
+
+// hasNextUsersPage reports whether the page after the given one should be
+// requested, given the number of users retrieved so far. Pages ending past the
+// search results the Management API returns are never requested, as they fail.
+func hasNextUsersPage(page, perPage, retrieved, maxResults int) bool {
+	return retrieved < maxResults && (page+2)*perPage <= usersSearchLimit
+}
+
+// buildUsersQuery combines the connection filter with the search query, as
+// the v3 search engine only supports filtering by connection as part of the
+// query itself.
+func buildUsersQuery(q, connection string) string {
+	if connection == "" {
+		return q
+	}
+	c := fmt.Sprintf("identities.connection:%q", connection)
+	if q == "" {
+		return c
+	}
+	return fmt.Sprintf("%s AND (%s)", c, q)
+}
+
+func flattenDataUser(api *management.Management, u *management.User, includeRoles bool) (map[string]interface{}, error) {
+	userMeta, err := structure.FlattenJsonToString(u.UserMetadata)
+	if err != nil {
+		return nil, err
+	}
+
+	appMeta, err := structure.FlattenJsonToString(u.AppMetadata)
+	if err != nil {
+		return nil, err
+	}
+
+	var roles []*management.Role
+	if includeRoles {
+		roles, err = readUserRoles(api, u.GetID())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var connection string
+	if len(u.Identities) > 0 {
+		connection = u.Identities[0].GetConnection()
+	}
+
+	return map[string]interface{}{
+		"user_id":         u.GetID(),
+		"connection_name": connection,
+		"username":        u.GetUsername(),
+		"name":            u.GetName(),
+		"family_name":     u.GetFamilyName(),
+		"given_name":      u.GetGivenName(),
+		"nickname":        u.GetNickname(),
+		"email":           u.GetEmail(),
+		"email_verified":  u.GetEmailVerified(),
+		"phone_number":    u.GetPhoneNumber(),
+		"phone_verified":  u.GetPhoneVerified(),
+		"blocked":         u.GetBlocked(),
+		"picture":         u.GetPicture(),
+		"user_metadata":   userMeta,
+		"app_metadata":    appMeta,
+		"roles":           flattenUserRoles(roles),
+	}, nil
+}
@@ -0,0 +1,111 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/alexkappa/terraform-provider-auth0/auth0/internal/random"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccDataUsers(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(testAccDataUsersConfig, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.auth0_users.search", "users.#", "2"),
+					random.TestCheckResourceAttr("data.auth0_users.search", "users.0.email", "alice.{{.random}}@acceptance.test.com", rand),
+					random.TestCheckResourceAttr("data.auth0_users.search", "users.1.email", "bob.{{.random}}@acceptance.test.com", rand),
+					resource.TestCheckResourceAttr("data.auth0_users.search", "users.0.connection_name", "Username-Password-Authentication"),
+					resource.TestCheckResourceAttr("data.auth0_users.search", "users.0.user_metadata", `{"team":"acceptance"}`),
+					resource.TestCheckResourceAttr("data.auth0_users.search", "users.0.roles.#", "1"),
+					resource.TestCheckResourceAttr("data.auth0_users.first", "users.#", "1"),
+					random.TestCheckResourceAttr("data.auth0_users.first", "users.0.email", "alice.{{.random}}@acceptance.test.com", rand),
+					resource.TestCheckResourceAttr("data.auth0_users.first", "users.0.roles.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataUsersConfig = `
+
+resource "auth0_role" "reader" {
+  name = "Acceptance Test - Data Users - {{.random}}"
+}
+
+resource "auth0_user" "alice" {
+  connection_name = "Username-Password-Authentication"
+  email = "alice.{{.random}}@acceptance.test.com"
+  password = "passpass$12$12"
+  user_metadata = jsonencode({ team = "acceptance" })
+  roles = [ auth0_role.reader.id ]
+}
+
+resource "auth0_user" "bob" {
+  connection_name = "Username-Password-Authentication"
+  email = "bob.{{.random}}@acceptance.test.com"
+  password = "passpass$12$12"
+}
+
+data "auth0_users" "search" {
+  q = "email:*.{{.random}}@acceptance.test.com"
+  connection_name = "Username-Password-Authentication"
+  sort = "email:1"
+  per_page = 1
+  include_roles = true
+
+  depends_on = [ auth0_user.alice, auth0_user.bob ]
+}
+
+data "auth0_users" "first" {
+  q = "email:*.{{.random}}@acceptance.test.com"
+  sort = "email:1"
+  max_results = 1
+
+  depends_on = [ auth0_user.alice, auth0_user.bob ]
+}
+`
+
+func TestBuildUsersQuery(t *testing.T) {
+	for _, test := range []struct {
+		q, connection, expected string
+	}{
+		{"", "", ""},
+		{`email:"alice@example.com"`, "", `email:"alice@example.com"`},
+		{"", "Username-Password-Authentication", `identities.connection:"Username-Password-Authentication"`},
+		{`name:"jane" OR name:"john"`, "db", `identities.connection:"db" AND (name:"jane" OR name:"john")`},
+	} {
+		if q := buildUsersQuery(test.q, test.connection); q != test.expected {
+			t.Errorf("Expected query %q, got %q", test.expected, q)
+		}
+	}
+}
+
+func TestHasNextUsersPage(t *testing.T) {
+	for _, test := range []struct {
+		page, perPage, retrieved, maxResults int
+		hasNext                              bool
+	}{
+		{0, 50, 50, 1000, true},
+		{0, 50, 50, 50, false},
+		{18, 50, 950, 1000, true},
+		{19, 50, 1000, 1000, false},
+		{9, 100, 1000, 1000, false},
+		{2, 30, 90, 1000, true},
+		{32, 30, 990, 1000, false},
+	} {
+		hasNext := hasNextUsersPage(test.page, test.perPage, test.retrieved, test.maxResults)
+		if hasNext != test.hasNext {
+			t.Errorf("expected hasNextUsersPage(%d, %d, %d, %d) to be %t, got %t",
+				test.page, test.perPage, test.retrieved, test.maxResults, test.hasNext, hasNext)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestDatasourceSchemaFromResourceSchema(t *testing.T) {
+
+	s := datasourceSchemaFromResourceSchema(map[string]*schema.Schema{
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: func(interface{}, string) ([]string, []error) { return nil, nil },
+		},
+		"secret": {
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+		},
+		"removed": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Removed:  "This field has been removed",
+		},
+		"nested": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+				},
+			},
+		},
+	})
+
+	if _, ok := s["removed"]; ok {
+		t.Errorf("Expected removed fields to be omitted")
+	}
+
+	for _, k := range []string{"name", "secret", "nested"} {
+		v, ok := s[k]
+		if !ok {
+			t.Fatalf("Expected %q to be part of the schema", k)
+		}
+		if !v.Computed || v.Optional || v.Required {
+			t.Errorf("Expected %q to be computed only", k)
+		}
+		if v.ForceNew || v.ValidateFunc != nil || v.MaxItems != 0 {
+			t.Errorf("Expected %q to have no configuration related settings", k)
+		}
+	}
+
+	if !s["secret"].Sensitive {
+		t.Errorf("Expected secret to remain sensitive")
+	}
+
+	nested := s["nested"].Elem.(*schema.Resource).Schema["enabled"]
+	if !nested.Computed || nested.Optional || nested.Default != nil {
+		t.Errorf("Expected nested fields to be computed only")
+	}
+
+	addOptionalFieldsToSchema(s, "name")
+	if !s["name"].Optional || !s["name"].Computed {
+		t.Errorf("Expected name to be optional and computed")
+	}
+}
@@ -0,0 +1,53 @@
+// Package limit implements an http.RoundTripper that caps the number of
+// requests to the Management API which are in flight at the same time.
+package limit
+
+import (
+	"log"
+	"net/http"
+	"time"
+)
+
+// Transport lets at most a fixed number of requests be in flight at once.
+// Other requests queue for a slot in the order they were made, as goroutines
+// blocked on a channel are woken up first in, first out.
+//
+// A request holds its slot until its response headers are received, or until
+// it fails. The slot isn't held while the body is read, as the SDK doesn't
+// close the body of every response.
+type Transport struct {
+	// Base is the transport used to make the requests. If nil,
+	// http.DefaultTransport is used.
+	Base http.RoundTripper
+
+	slots chan struct{}
+}
+
+// NewTransport returns a Transport wrapping base, allowing at most max
+// requests to be in flight at once.
+func NewTransport(base http.RoundTripper, max int) *Transport {
+	return &Transport{
+		Base:  base,
+		slots: make(chan struct{}, max),
+	}
+}
+
+// RoundTrip waits for a slot to be available, then executes a single HTTP
+// transaction.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	start := time.Now()
+	select {
+	case t.slots <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+	log.Printf("[DEBUG] Waited %s for a slot to send %s %s", time.Since(start), req.Method, req.URL.Path)
+
+	defer func() { <-t.slots }()
+	return base.RoundTrip(req)
+}
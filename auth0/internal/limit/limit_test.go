@@ -0,0 +1,112 @@
+package limit
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestTransport(t *testing.T) {
+	var inFlight, maxInFlight int32
+
+	transport := NewTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		n := atomic.AddInt32(&inFlight, 1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+		}, nil
+	}), 2)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequest("GET", "https://example.auth0.com/api/v2/clients", nil)
+			res, err := transport.RoundTrip(req)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			res.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if maxInFlight > 2 {
+		t.Errorf("expected at most 2 requests in flight, got %d", maxInFlight)
+	}
+	if len(transport.slots) != 0 {
+		t.Errorf("expected all slots to be released, %d are still held", len(transport.slots))
+	}
+}
+
+func TestTransportReleasesSlotWithoutBodyBeingClosed(t *testing.T) {
+	transport := NewTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: 404,
+			Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+		}, nil
+	}), 1)
+
+	// The SDK doesn't close the body of error and 204 responses, which must
+	// not keep later requests from being sent.
+	for i := 0; i < 3; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		req, _ := http.NewRequest("GET", "https://example.auth0.com/api/v2/clients/abc123", nil)
+		_, err := transport.RoundTrip(req.WithContext(ctx))
+		cancel()
+		if err != nil {
+			t.Fatalf("request %d: unexpected error: %v", i+1, err)
+		}
+	}
+	if len(transport.slots) != 0 {
+		t.Errorf("expected all slots to be released, %d are still held", len(transport.slots))
+	}
+}
+
+func TestTransportWaitsForSlot(t *testing.T) {
+	release := make(chan struct{})
+	transport := NewTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		<-release
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+		}, nil
+	}), 1)
+
+	go func() {
+		req, _ := http.NewRequest("GET", "https://example.auth0.com/api/v2/clients", nil)
+		transport.RoundTrip(req)
+	}()
+	for len(transport.slots) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequest("GET", "https://example.auth0.com/api/v2/clients", nil)
+	if _, err := transport.RoundTrip(req.WithContext(ctx)); err != context.DeadlineExceeded {
+		t.Errorf("expected the second request to time out waiting for a slot, got %v", err)
+	}
+	close(release)
+}
@@ -0,0 +1,122 @@
+// Package profile loads named sets of Auth0 credentials from a local file.
+//
+// The file is made of sections, each holding the settings of one profile:
+//
+//	[default]
+//	domain        = example.auth0.com
+//	client_id     = abc123
+//	client_secret = secret
+//
+//	[ci]
+//	domain    = example.auth0.com
+//	api_token = eyJhbGci...
+//
+// Blank lines and lines starting with # or ; are ignored.
+package profile
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultPath is where the credentials file is looked up when no other path
+// is given. A leading ~ stands for the home directory of the user.
+const DefaultPath = "~/.auth0/credentials"
+
+// Profile holds the settings of a single profile.
+type Profile struct {
+	Domain       string
+	ClientID     string
+	ClientSecret string
+	APIToken     string
+	Audience     string
+}
+
+// Load reads the named profile from the credentials file at path.
+func Load(path, name string) (*Profile, error) {
+	path, err := expand(path)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	profiles, err := parse(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse credentials file %s: %v", path, err)
+	}
+	p, ok := profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("profile %q not found in credentials file %s", name, path)
+	}
+	return p, nil
+}
+
+func parse(r io.Reader) (map[string]*Profile, error) {
+	profiles := make(map[string]*Profile)
+
+	var p *Profile
+	s := bufio.NewScanner(r)
+	for n := 1; s.Scan(); n++ {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if name == "" {
+				return nil, fmt.Errorf("line %d: empty profile name", n)
+			}
+			p = &Profile{}
+			profiles[name] = p
+			continue
+		}
+
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("line %d: expected key = value", n)
+		}
+		if p == nil {
+			return nil, fmt.Errorf("line %d: setting outside of a profile", n)
+		}
+
+		key, value := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+		switch key {
+		case "domain":
+			p.Domain = value
+		case "client_id":
+			p.ClientID = value
+		case "client_secret":
+			p.ClientSecret = value
+		case "api_token":
+			p.APIToken = value
+		case "audience":
+			p.Audience = value
+		default:
+			return nil, fmt.Errorf("line %d: unknown setting %q", n, key)
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
+
+func expand(path string) (string, error) {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, path[1:]), nil
+}
@@ -0,0 +1,90 @@
+package profile
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const credentials = `
+# Used by default.
+[default]
+domain        = example.auth0.com
+client_id     = abc123
+client_secret = secret
+
+; Used by CI runners.
+[ci]
+domain    = login.example.com
+api_token = token
+audience  = https://example.auth0.com/api/v2/
+`
+
+func TestParse(t *testing.T) {
+	profiles, err := parse(strings.NewReader(credentials))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[string]*Profile{
+		"default": {
+			Domain:       "example.auth0.com",
+			ClientID:     "abc123",
+			ClientSecret: "secret",
+		},
+		"ci": {
+			Domain:   "login.example.com",
+			APIToken: "token",
+			Audience: "https://example.auth0.com/api/v2/",
+		},
+	}
+	if !reflect.DeepEqual(profiles, expected) {
+		t.Errorf("expected profiles %+v, got %+v", expected, profiles)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for input, expected := range map[string]string{
+		"domain = example.auth0.com":           "line 1: setting outside of a profile",
+		"[default]\nfoo = bar":                 `line 2: unknown setting "foo"`,
+		"[default]\ndomain example.auth0.com":  "line 2: expected key = value",
+		"[]\ndomain = example.auth0.com":       "line 1: empty profile name",
+		"\n\n[ default ]\nregion":              "line 4: expected key = value",
+		"[default]\nclient_secret = a=b\nfoo=": `line 3: unknown setting "foo"`,
+	} {
+		_, err := parse(strings.NewReader(input))
+		if err == nil || err.Error() != expected {
+			t.Errorf("expected error %q for %q, got %v", expected, input, err)
+		}
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "auth0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "credentials")
+	if err := ioutil.WriteFile(path, []byte(credentials), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := Load(path, "ci")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p.APIToken != "token" {
+		t.Errorf("expected api token %q, got %q", "token", p.APIToken)
+	}
+
+	if _, err := Load(path, "prod"); err == nil {
+		t.Error("expected an error loading a missing profile")
+	}
+	if _, err := Load(filepath.Join(dir, "missing"), "default"); err == nil {
+		t.Error("expected an error loading a missing file")
+	}
+}
@@ -0,0 +1,214 @@
+// Package retry implements an http.RoundTripper that retries requests to the
+// Management API which failed because of rate limiting or transient errors.
+package retry
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// baseDelay is the delay before the first retry of a request that failed with
+// a server or network error. It doubles with every further retry.
+const baseDelay = 500 * time.Millisecond
+
+// Transport retries requests that failed with 429 Too Many Requests, a server
+// error or a network error.
+//
+// Rate limited requests were rejected before they were processed, so they are
+// retried regardless of their method, once the time given by the Retry-After
+// or X-RateLimit-Reset headers has passed. Requests that failed with a server
+// or network error may have been processed, so only idempotent ones are
+// retried, with exponential backoff and jitter.
+type Transport struct {
+	// Base is the transport used to make the requests. If nil,
+	// http.DefaultTransport is used.
+	Base http.RoundTripper
+
+	// MaxRetries is the number of times a request is retried before giving
+	// up and returning the last response or error.
+	MaxRetries int
+
+	// MaxWait is the longest the transport waits before a retry. A rate
+	// limited request which may not be repeated for longer is not retried.
+	//
+	// Rate limited requests which are not retried fail with an error, rather
+	// than returning the 429 response.
+	MaxWait time.Duration
+
+	// now and sleep are replaced in tests.
+	now   func() time.Time
+	sleep func(context.Context, time.Duration) error
+}
+
+// NewTransport returns a Transport wrapping base.
+func NewTransport(base http.RoundTripper, maxRetries int, maxWait time.Duration) *Transport {
+	return &Transport{
+		Base:       base,
+		MaxRetries: maxRetries,
+		MaxWait:    maxWait,
+	}
+}
+
+// RoundTrip executes a single HTTP transaction, retrying it if it failed.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	for attempt := 0; ; attempt++ {
+		res, err := base.RoundTrip(req)
+
+		wait, ok := t.wait(req, res, err, attempt)
+		if !ok {
+			return res, err
+		}
+		if attempt >= t.MaxRetries || !replayable(req) || wait > t.MaxWait {
+			return giveUp(res, err, attempt)
+		}
+
+		if res != nil {
+			drain(res)
+		}
+		if err := t.pause(req.Context(), wait); err != nil {
+			return nil, err
+		}
+		if req.Body != nil && req.Body != http.NoBody {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+// giveUp returns the outcome of the last attempt at a request. Rate limited
+// responses are turned into an error, as the SDK would otherwise retry them
+// again, without any limit.
+func giveUp(res *http.Response, err error, retries int) (*http.Response, error) {
+	if res == nil || res.StatusCode != http.StatusTooManyRequests {
+		return res, err
+	}
+	drain(res)
+	return nil, fmt.Errorf("rate limited by the Management API after %d retries", retries)
+}
+
+func drain(res *http.Response) {
+	io.Copy(ioutil.Discard, res.Body)
+	res.Body.Close()
+}
+
+// wait reports whether the outcome of a request should be retried, and how
+// long to wait before doing so.
+func (t *Transport) wait(req *http.Request, res *http.Response, err error, attempt int) (time.Duration, bool) {
+	if err != nil {
+		if req.Context().Err() != nil || !idempotent(req.Method) {
+			return 0, false
+		}
+		return t.backoff(attempt), true
+	}
+
+	switch res.StatusCode {
+	case http.StatusTooManyRequests:
+		if wait, ok := t.rateLimitReset(res); ok {
+			return wait, true
+		}
+		return t.backoff(attempt), true
+	case http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		if !idempotent(req.Method) {
+			return 0, false
+		}
+		return t.backoff(attempt), true
+	}
+	return 0, false
+}
+
+// rateLimitReset returns how long to wait before repeating a rate limited
+// request, based on the Retry-After header, or failing that the
+// X-RateLimit-Reset header, which holds the time the limit resets at as a
+// Unix timestamp.
+func (t *Transport) rateLimitReset(res *http.Response) (time.Duration, bool) {
+	if v := res.Header.Get("Retry-After"); v != "" {
+		if seconds, err := strconv.Atoi(v); err == nil {
+			return time.Duration(seconds) * time.Second, true
+		}
+		if at, err := http.ParseTime(v); err == nil {
+			return nonNegative(at.Sub(t.clock())), true
+		}
+	}
+	if v := res.Header.Get("X-RateLimit-Reset"); v != "" {
+		if reset, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return nonNegative(time.Unix(reset, 0).Sub(t.clock())), true
+		}
+	}
+	return 0, false
+}
+
+// backoff returns the delay before the given retry. The delay doubles with
+// each attempt, up to MaxWait, and half of it is randomized so that clients
+// which failed together don't retry together.
+func (t *Transport) backoff(attempt int) time.Duration {
+	d := baseDelay << uint(attempt)
+	if d <= 0 || d > t.MaxWait {
+		d = t.MaxWait
+	}
+	if half := int64(d / 2); half > 0 {
+		d = time.Duration(half + rand.Int63n(half+1))
+	}
+	return d
+}
+
+func (t *Transport) clock() time.Time {
+	if t.now != nil {
+		return t.now()
+	}
+	return time.Now()
+}
+
+func (t *Transport) pause(ctx context.Context, d time.Duration) error {
+	if t.sleep != nil {
+		return t.sleep(ctx, d)
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// replayable reports whether the request can be sent again, which requires
+// its body, if any, to be recreated.
+func replayable(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// idempotent reports whether repeating a request with the given method has
+// the same effect as sending it once.
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func nonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
@@ -0,0 +1,276 @@
+package retry
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gopkg.in/auth0.v5/management"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func response(status int, header http.Header) *http.Response {
+	if header == nil {
+		header = make(http.Header)
+	}
+	return &http.Response{
+		StatusCode: status,
+		Header:     header,
+		Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+	}
+}
+
+func TestTransport(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	networkErr := errors.New("connection reset by peer")
+
+	for _, test := range []struct {
+		name      string
+		method    string
+		responses []*http.Response
+		errs      []error
+		attempts  int
+		status    int
+		err       string
+		waits     []time.Duration
+	}{
+		{
+			name:      "success",
+			method:    "GET",
+			responses: []*http.Response{response(200, nil)},
+			attempts:  1,
+			status:    200,
+		},
+		{
+			name:   "rate limited with Retry-After",
+			method: "POST",
+			responses: []*http.Response{
+				response(429, http.Header{"Retry-After": {"2"}}),
+				response(201, nil),
+			},
+			attempts: 2,
+			status:   201,
+			waits:    []time.Duration{2 * time.Second},
+		},
+		{
+			name:   "rate limited with X-RateLimit-Reset",
+			method: "PATCH",
+			responses: []*http.Response{
+				response(429, http.Header{"X-Ratelimit-Reset": {strconv.FormatInt(now.Unix()+3, 10)}}),
+				response(200, nil),
+			},
+			attempts: 2,
+			status:   200,
+			waits:    []time.Duration{3 * time.Second},
+		},
+		{
+			name:   "rate limited for longer than max wait",
+			method: "GET",
+			responses: []*http.Response{
+				response(429, http.Header{"Retry-After": {"60"}}),
+			},
+			attempts: 1,
+			err:      "rate limited by the Management API after 0 retries",
+		},
+		{
+			name:   "rate limited too many times",
+			method: "GET",
+			responses: []*http.Response{
+				response(429, http.Header{"Retry-After": {"1"}}),
+				response(429, http.Header{"Retry-After": {"1"}}),
+				response(429, http.Header{"Retry-After": {"1"}}),
+				response(429, http.Header{"Retry-After": {"1"}}),
+			},
+			attempts: 4,
+			err:      "rate limited by the Management API after 3 retries",
+		},
+		{
+			name:   "server error on idempotent request",
+			method: "DELETE",
+			responses: []*http.Response{
+				response(503, nil),
+				response(502, nil),
+				response(204, nil),
+			},
+			attempts: 3,
+			status:   204,
+		},
+		{
+			name:   "server error on non-idempotent request",
+			method: "POST",
+			responses: []*http.Response{
+				response(500, nil),
+			},
+			attempts: 1,
+			status:   500,
+		},
+		{
+			name:   "client error",
+			method: "GET",
+			responses: []*http.Response{
+				response(400, nil),
+			},
+			attempts: 1,
+			status:   400,
+		},
+		{
+			name:     "network error",
+			method:   "GET",
+			errs:     []error{networkErr, nil},
+			attempts: 2,
+			status:   200,
+		},
+		{
+			name:   "too many retries",
+			method: "GET",
+			responses: []*http.Response{
+				response(500, nil),
+				response(500, nil),
+				response(500, nil),
+				response(500, nil),
+			},
+			attempts: 4,
+			status:   500,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var attempts int
+			var bodies []string
+			var waits []time.Duration
+
+			transport := NewTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				defer func() { attempts++ }()
+				if req.Body != nil {
+					b, _ := ioutil.ReadAll(req.Body)
+					bodies = append(bodies, string(b))
+				}
+				if attempts < len(test.errs) && test.errs[attempts] != nil {
+					return nil, test.errs[attempts]
+				}
+				if attempts < len(test.responses) {
+					return test.responses[attempts], nil
+				}
+				return response(200, nil), nil
+			}), 3, 10*time.Second)
+			transport.now = func() time.Time { return now }
+			transport.sleep = func(_ context.Context, d time.Duration) error {
+				waits = append(waits, d)
+				return nil
+			}
+
+			req, _ := http.NewRequest(test.method, "https://example.auth0.com/api/v2/clients", bytes.NewBufferString(`{"name":"foo"}`))
+			res, err := transport.RoundTrip(req)
+			if test.err != "" {
+				if err == nil || err.Error() != test.err {
+					t.Errorf("expected error %q, got %v", test.err, err)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			} else if res.StatusCode != test.status {
+				t.Errorf("expected status %d, got %d", test.status, res.StatusCode)
+			}
+			if attempts != test.attempts {
+				t.Errorf("expected %d attempts, got %d", test.attempts, attempts)
+			}
+			for i, body := range bodies {
+				if body != `{"name":"foo"}` {
+					t.Errorf("expected attempt %d to send the request body, got %q", i+1, body)
+				}
+			}
+			for i, wait := range test.waits {
+				if waits[i] != wait {
+					t.Errorf("expected wait %d to be %s, got %s", i+1, wait, waits[i])
+				}
+			}
+		})
+	}
+}
+
+func TestTransportNetworkErrorOnNonIdempotentRequest(t *testing.T) {
+	networkErr := errors.New("connection reset by peer")
+
+	var attempts int
+	transport := NewTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		return nil, networkErr
+	}), 3, 10*time.Second)
+
+	req, _ := http.NewRequest("POST", "https://example.auth0.com/api/v2/clients", nil)
+	if _, err := transport.RoundTrip(req); err != networkErr {
+		t.Errorf("expected error %v, got %v", networkErr, err)
+	}
+	if attempts != 1 {
+		t.Errorf("expected 1 attempt, got %d", attempts)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	transport := NewTransport(nil, 10, 5*time.Second)
+	for attempt, max := range []time.Duration{
+		500 * time.Millisecond,
+		1 * time.Second,
+		2 * time.Second,
+		4 * time.Second,
+		5 * time.Second,
+		5 * time.Second,
+	} {
+		for i := 0; i < 100; i++ {
+			if d := transport.backoff(attempt); d < max/2 || d > max {
+				t.Fatalf("expected backoff of attempt %d to be between %s and %s, got %s", attempt, max/2, max, d)
+			}
+		}
+	}
+}
+
+// TestTransportWithManagement checks that the SDK, which retries rate limited
+// requests on its own, gives up along with the transport.
+func TestTransportWithManagement(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Unix(), 10))
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	transport := NewTransport(http.DefaultTransport, 2, time.Second)
+	transport.sleep = func(context.Context, time.Duration) error { return nil }
+
+	api, err := management.New(strings.TrimPrefix(server.URL, "http://"),
+		management.WithInsecure(),
+		management.WithClient(&http.Client{Transport: transport}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := api.Client.Read("abc123")
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		if err == nil || !strings.Contains(err.Error(), "rate limited by the Management API after 2 retries") {
+			t.Errorf("expected the request to fail as rate limited, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the request to give up")
+	}
+	if n := atomic.LoadInt32(&attempts); n != 3 {
+		t.Errorf("expected 3 attempts, got %d", n)
+	}
+}
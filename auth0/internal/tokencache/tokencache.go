@@ -0,0 +1,156 @@
+// Package tokencache shares Management API access tokens between runs of the
+// provider by caching them on disk.
+package tokencache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	// expiryMargin is how long before it expires a cached token stops being
+	// used, so that it doesn't expire while a run is in progress.
+	expiryMargin = 5 * time.Minute
+
+	// lockTimeout is how long to wait for another process to release the lock
+	// of a cache entry.
+	lockTimeout = 30 * time.Second
+
+	// staleLockAge is the age after which a lock is considered left behind by
+	// a process that exited without releasing it.
+	staleLockAge = time.Minute
+)
+
+// Key returns the name of the cache entry holding tokens for the given
+// parts, e.g. the domain, client ID and audience they were issued for.
+func Key(parts ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return hex.EncodeToString(sum[:])
+}
+
+// New returns a token source that reads tokens from the cache entry named key
+// in dir, and only requests new ones from base when the cached one is about
+// to expire.
+//
+// Tokens are cached in files only readable by the current user, and the lock
+// of an entry is held while a new token is requested, so that processes
+// running at the same time request a single token. If the cache can't be
+// used, tokens are requested from base directly.
+func New(dir, key string, base oauth2.TokenSource) oauth2.TokenSource {
+	return oauth2.ReuseTokenSource(nil, &source{
+		path: filepath.Join(dir, key+".json"),
+		base: base,
+		now:  time.Now,
+	})
+}
+
+type source struct {
+	path string
+	base oauth2.TokenSource
+	now  func() time.Time
+}
+
+func (s *source) Token() (*oauth2.Token, error) {
+	unlock, err := s.lock()
+	if err != nil {
+		log.Printf("[WARN] Not using the token cache: %v", err)
+		return s.base.Token()
+	}
+	defer unlock()
+
+	if t, err := s.read(); err == nil && s.valid(t) {
+		log.Printf("[DEBUG] Using cached token from %s", s.path)
+		return t, nil
+	}
+
+	t, err := s.base.Token()
+	if err != nil {
+		return nil, err
+	}
+	if !t.Expiry.IsZero() {
+		if err := s.write(t); err != nil {
+			log.Printf("[WARN] Failed to cache token: %v", err)
+		}
+	}
+	return t, nil
+}
+
+// valid reports whether the token can be used for at least expiryMargin.
+func (s *source) valid(t *oauth2.Token) bool {
+	return t.AccessToken != "" && !t.Expiry.IsZero() && s.now().Add(expiryMargin).Before(t.Expiry)
+}
+
+func (s *source) read() (*oauth2.Token, error) {
+	b, err := ioutil.ReadFile(s.path)
+	if err != nil {
+		return nil, err
+	}
+	var t oauth2.Token
+	if err := json.Unmarshal(b, &t); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// write replaces the cached token. The token is written to a temporary file,
+// which is only readable by the current user, then renamed so that the entry
+// is never read while partially written.
+func (s *source) write(t *oauth2.Token) error {
+	b, err := json.Marshal(t)
+	if err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(filepath.Dir(s.path), filepath.Base(s.path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), s.path)
+}
+
+// lock takes the lock of the cache entry, a file created next to it which
+// only one process can create at a time. It returns a func releasing it.
+func (s *source) lock() (func(), error) {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0700); err != nil {
+		return nil, err
+	}
+
+	path := s.path + ".lock"
+	deadline := s.now().Add(lockTimeout)
+	for {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err == nil {
+			f.Close()
+			return func() { os.Remove(path) }, nil
+		}
+		if !os.IsExist(err) {
+			return nil, err
+		}
+
+		if info, err := os.Stat(path); err == nil && s.now().Sub(info.ModTime()) > staleLockAge {
+			os.Remove(path)
+			continue
+		}
+		if s.now().After(deadline) {
+			return nil, fmt.Errorf("timed out waiting for lock %s", path)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
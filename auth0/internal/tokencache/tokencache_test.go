@@ -0,0 +1,117 @@
+package tokencache
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+type countingSource struct {
+	tokens int
+	expiry time.Duration
+}
+
+func (s *countingSource) Token() (*oauth2.Token, error) {
+	s.tokens++
+	return &oauth2.Token{
+		AccessToken: "token",
+		TokenType:   "Bearer",
+		Expiry:      time.Now().Add(s.expiry),
+	}, nil
+}
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "auth0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestCache(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	base := &countingSource{expiry: time.Hour}
+	key := Key("example.auth0.com", "abc123", "https://example.auth0.com/api/v2/")
+
+	// Each source stands for a separate run of the provider.
+	for i := 0; i < 3; i++ {
+		tok, err := New(dir, key, base).Token()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if tok.AccessToken != "token" {
+			t.Errorf("expected access token %q, got %q", "token", tok.AccessToken)
+		}
+	}
+	if base.tokens != 1 {
+		t.Errorf("expected 1 token to be requested, got %d", base.tokens)
+	}
+
+	if runtime.GOOS != "windows" {
+		info, err := os.Stat(filepath.Join(dir, key+".json"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if mode := info.Mode().Perm(); mode != 0600 {
+			t.Errorf("expected the cache entry to have mode 0600, got %o", mode)
+		}
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, key+".json.lock")); !os.IsNotExist(err) {
+		t.Errorf("expected the lock to be released, got %v", err)
+	}
+}
+
+func TestCacheKeys(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	base := &countingSource{expiry: time.Hour}
+	New(dir, Key("example.auth0.com", "abc123", "https://example.auth0.com/api/v2/"), base).Token()
+	New(dir, Key("example.auth0.com", "def456", "https://example.auth0.com/api/v2/"), base).Token()
+	if base.tokens != 2 {
+		t.Errorf("expected 2 tokens to be requested, got %d", base.tokens)
+	}
+}
+
+func TestCacheExpiry(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	// Tokens expiring within the margin are not reused.
+	base := &countingSource{expiry: expiryMargin - time.Minute}
+	New(dir, "key", base).Token()
+	New(dir, "key", base).Token()
+	if base.tokens != 2 {
+		t.Errorf("expected 2 tokens to be requested, got %d", base.tokens)
+	}
+}
+
+func TestCacheStaleLock(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	lock := filepath.Join(dir, "key.json.lock")
+	if err := ioutil.WriteFile(lock, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	old := time.Now().Add(-2 * staleLockAge)
+	if err := os.Chtimes(lock, old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	base := &countingSource{expiry: time.Hour}
+	if _, err := New(dir, "key", base).Token(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "key.json")); err != nil {
+		t.Errorf("expected the token to be cached once the stale lock was removed, got %v", err)
+	}
+}
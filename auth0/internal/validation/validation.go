@@ -3,6 +3,7 @@ package validation
 import (
 	"fmt"
 	"net/url"
+	"strings"
 )
 
 // IsURLWithNoFragment is a SchemaValidateFunc which tests if the provided value
@@ -37,3 +38,23 @@ func IsURLWithNoFragment(i interface{}, k string) (warnings []string, errors []e
 
 	return
 }
+
+// UniversalLoginTemplateContainsCorrectTags is a SchemaValidateFunc which tests
+// if the provided value is of type string and contains the tags required by a
+// Universal Login page template.
+func UniversalLoginTemplateContainsCorrectTags(i interface{}, k string) (warnings []string, errors []error) {
+
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	for _, tag := range []string{"{%- auth0:head -%}", "{%- auth0:widget -%}"} {
+		if !strings.Contains(v, tag) {
+			errors = append(errors, fmt.Errorf("expected %q to contain the %s tag", k, tag))
+		}
+	}
+
+	return
+}
@@ -16,3 +16,20 @@ func TestIsURLWithNoFragment(t *testing.T) {
 		}
 	}
 }
+
+func TestUniversalLoginTemplateContainsCorrectTags(t *testing.T) {
+	for template, valid := range map[string]bool{
+		"<html><head>{%- auth0:head -%}</head><body>{%- auth0:widget -%}</body></html>": true,
+		"<html><head>{%- auth0:head -%}</head><body></body></html>":                     false,
+		"<html><head></head><body>{%- auth0:widget -%}</body></html>":                   false,
+		"<html><head>{{ auth0:head }}</head><body>{{ auth0:widget }}</body></html>":     false,
+	} {
+		_, errs := UniversalLoginTemplateContainsCorrectTags(template, "body")
+		if len(errs) > 0 && valid {
+			t.Errorf("UniversalLoginTemplateContainsCorrectTags(%s) produced an unexpected error", template)
+		}
+		if len(errs) == 0 && !valid {
+			t.Errorf("UniversalLoginTemplateContainsCorrectTags(%s) was expected to produce an error", template)
+		}
+	}
+}
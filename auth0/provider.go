@@ -1,12 +1,26 @@
 package auth0
 
 import (
+	"context"
 	"fmt"
+	"log"
+	"net/http"
+	"net/url"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/alexkappa/terraform-provider-auth0/auth0/internal/limit"
+	"github.com/alexkappa/terraform-provider-auth0/auth0/internal/profile"
+	"github.com/alexkappa/terraform-provider-auth0/auth0/internal/retry"
+	"github.com/alexkappa/terraform-provider-auth0/auth0/internal/tokencache"
 	"github.com/alexkappa/terraform-provider-auth0/version"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/meta"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
 
 	"gopkg.in/auth0.v5"
 	"gopkg.in/auth0.v5/management"
@@ -19,19 +33,45 @@ func init() {
 		Schema: map[string]*schema.Schema{
 			"domain": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("AUTH0_DOMAIN", nil),
 			},
 			"client_id": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("AUTH0_CLIENT_ID", nil),
 			},
 			"client_secret": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("AUTH0_CLIENT_SECRET", nil),
 			},
+			"api_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("AUTH0_API_TOKEN", nil),
+			},
+			"audience": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AUTH0_AUDIENCE", nil),
+			},
+			"profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AUTH0_PROFILE", nil),
+			},
+			"credentials_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AUTH0_CREDENTIALS_FILE", profile.DefaultPath),
+			},
+			"token_cache_dir": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AUTH0_TOKEN_CACHE_DIR", nil),
+			},
 			"debug": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -43,27 +83,78 @@ func init() {
 					return v == "1" || v == "true" || v == "on", nil
 				},
 			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  envIntDefaultFunc("AUTH0_MAX_RETRIES", 3),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"max_retry_wait": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  envIntDefaultFunc("AUTH0_MAX_RETRY_WAIT", 30),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  envIntDefaultFunc("AUTH0_MAX_CONCURRENT_REQUESTS", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"auth0_client":          newClient(),
-			"auth0_global_client":   newGlobalClient(),
-			"auth0_client_grant":    newClientGrant(),
-			"auth0_connection":      newConnection(),
-			"auth0_custom_domain":   newCustomDomain(),
-			"auth0_resource_server": newResourceServer(),
-			"auth0_rule":            newRule(),
-			"auth0_rule_config":     newRuleConfig(),
-			"auth0_hook":            newHook(),
-			"auth0_prompt":          newPrompt(),
-			"auth0_email":           newEmail(),
-			"auth0_email_template":  newEmailTemplate(),
-			"auth0_user":            newUser(),
-			"auth0_tenant":          newTenant(),
-			"auth0_role":            newRole(),
-			"auth0_log_stream":      newLogStream(),
+			"auth0_client":                      newClient(),
+			"auth0_global_client":               newGlobalClient(),
+			"auth0_client_grant":                newClientGrant(),
+			"auth0_client_credentials":          newClientCredentials(),
+			"auth0_connection":                  newConnection(),
+			"auth0_connection_client":           newConnectionClient(),
+			"auth0_custom_domain":               newCustomDomain(),
+			"auth0_resource_server":             newResourceServer(),
+			"auth0_resource_server_scope":       newResourceServerScope(),
+			"auth0_signing_key_rotation":        newSigningKeyRotation(),
+			"auth0_rule":                        newRule(),
+			"auth0_rule_config":                 newRuleConfig(),
+			"auth0_hook":                        newHook(),
+			"auth0_prompt":                      newPrompt(),
+			"auth0_prompt_custom_text":          newPromptCustomText(),
+			"auth0_email":                       newEmail(),
+			"auth0_email_template":              newEmailTemplate(),
+			"auth0_user":                        newUser(),
+			"auth0_user_role":                   newUserRole(),
+			"auth0_user_permissions":            newUserPermissions(),
+			"auth0_tenant":                      newTenant(),
+			"auth0_role":                        newRole(),
+			"auth0_role_permission":             newRolePermission(),
+			"auth0_log_stream":                  newLogStream(),
+			"auth0_guardian":                    newGuardian(),
+			"auth0_branding":                    newBranding(),
+			"auth0_organization":                newOrganization(),
+			"auth0_organization_member":         newOrganizationMember(),
+			"auth0_action":                      newAction(),
+			"auth0_trigger_binding":             newTriggerBinding(),
+			"auth0_brute_force_protection":      newBruteForceProtection(),
+			"auth0_suspicious_ip_throttling":    newSuspiciousIPThrottling(),
+			"auth0_breached_password_detection": newBreachedPasswordDetection(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"auth0_client":          newDataClient(),
+			"auth0_resource_server": newDataResourceServer(),
+			"auth0_connection":      newDataConnection(),
+			"auth0_tenant":          newDataTenant(),
+			"auth0_users":           newDataUsers(),
+			"auth0_role":            newDataRole(),
+			"auth0_signing_keys":    newDataSigningKeys(),
 		},
 		ConfigureFunc: Configure,
 	}
+
+	for name, r := range provider.ResourcesMap {
+		withScopes(name, r, resourceScopes[name])
+	}
+	for name, r := range provider.DataSourcesMap {
+		withScopes(name, r, dataSourceScopes[name])
+	}
 }
 
 func Provider() *schema.Provider {
@@ -72,10 +163,14 @@ func Provider() *schema.Provider {
 
 func Configure(data *schema.ResourceData) (interface{}, error) {
 
-	domain := data.Get("domain").(string)
-	id := data.Get("client_id").(string)
-	secret := data.Get("client_secret").(string)
+	c, err := providerCredentials(data)
+	if err != nil {
+		return nil, err
+	}
 	debug := data.Get("debug").(bool)
+	maxRetries := data.Get("max_retries").(int)
+	maxRetryWait := time.Duration(data.Get("max_retry_wait").(int)) * time.Second
+	maxConcurrentRequests := data.Get("max_concurrent_requests").(int)
 
 	userAgent := fmt.Sprintf("Terraform-Provider-Auth0/%s (Go-Auth0-SDK/%s; Terraform-SDK/%s; Terraform/%s)",
 		Version(),
@@ -83,10 +178,147 @@ func Configure(data *schema.ResourceData) (interface{}, error) {
 		TerraformSDKVersion(),
 		TerraformVersion())
 
-	return management.New(domain,
-		management.WithClientCredentials(id, secret),
+	// Each retry waits for a slot of its own, so that requests backing off
+	// don't keep others from being sent.
+	transport := http.DefaultTransport
+	if maxConcurrentRequests > 0 {
+		transport = limit.NewTransport(transport, maxConcurrentRequests)
+	}
+	transport = retry.NewTransport(transport, maxRetries, maxRetryWait)
+
+	// The SDK can only authenticate with a static token, or with client
+	// credentials for the default audience, so requests are authenticated by
+	// our own transport. It overrides the Authorization header the SDK sets,
+	// for which it's given a placeholder token.
+	source := tokenSource(context.Background(), c, data.Get("token_cache_dir").(string))
+	transport = &oauth2.Transport{
+		Source: source,
+		Base:   transport,
+	}
+
+	// Requesting a token up front reports invalid credentials before any
+	// resource is read, and tells which scopes the resources can rely on.
+	token, err := source.Token()
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate with the Auth0 Management API: %v", err)
+	}
+
+	api, err := management.New(c.Domain,
+		management.WithStaticToken("placeholder"),
 		management.WithDebug(debug),
-		management.WithUserAgent(userAgent))
+		management.WithUserAgent(userAgent),
+		management.WithClient(&http.Client{Transport: transport}))
+	if err != nil {
+		return nil, err
+	}
+
+	if scopes, ok := tokenScopes(token.AccessToken); ok {
+		grantedScopes.Store(api, scopes)
+	} else {
+		log.Printf("[WARN] The access token is not a JWT, so its scopes can't be checked")
+	}
+	return api, nil
+}
+
+// providerCredentials returns the credentials used to authenticate with the
+// Management API. Those set in the provider configuration, or through
+// environment variables, take precedence over the ones of the profile.
+func providerCredentials(data *schema.ResourceData) (*profile.Profile, error) {
+	c := &profile.Profile{}
+	if name := data.Get("profile").(string); name != "" {
+		p, err := profile.Load(data.Get("credentials_file").(string), name)
+		if err != nil {
+			return nil, err
+		}
+		c = p
+	}
+
+	for key, value := range map[string]*string{
+		"domain":        &c.Domain,
+		"client_id":     &c.ClientID,
+		"client_secret": &c.ClientSecret,
+		"api_token":     &c.APIToken,
+		"audience":      &c.Audience,
+	} {
+		if v := data.Get(key).(string); v != "" {
+			*value = v
+		}
+	}
+
+	if err := validateCredentials(c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// validateCredentials rejects credentials missing a setting, or combining
+// ways of authenticating.
+func validateCredentials(c *profile.Profile) error {
+	if c.Domain == "" {
+		return fmt.Errorf("domain must be set, in the provider configuration, the AUTH0_DOMAIN environment variable or a profile")
+	}
+	if c.APIToken != "" {
+		if c.ClientID != "" || c.ClientSecret != "" {
+			return fmt.Errorf("api_token conflicts with client_id and client_secret, only one way of authenticating can be used")
+		}
+		if c.Audience != "" {
+			return fmt.Errorf("audience can only be set along with client_id and client_secret, as api_token was already issued for an audience")
+		}
+		return nil
+	}
+	if c.ClientID == "" || c.ClientSecret == "" {
+		return fmt.Errorf("either api_token, or both client_id and client_secret must be set")
+	}
+	return nil
+}
+
+// tokenSource returns the source of the tokens used to authenticate with the
+// Management API. Tokens are requested for the audience of the Management API
+// of the domain, unless another audience is given. If cacheDir is set, they
+// are cached there and shared with other runs of the provider.
+func tokenSource(ctx context.Context, c *profile.Profile, cacheDir string) oauth2.TokenSource {
+	if c.APIToken != "" {
+		return oauth2.StaticTokenSource(&oauth2.Token{AccessToken: c.APIToken})
+	}
+
+	domain := c.Domain
+	if i := strings.Index(domain, "//"); i != -1 {
+		domain = domain[i+2:]
+	}
+	audience := c.Audience
+	if audience == "" {
+		audience = "https://" + domain + "/api/v2/"
+	}
+
+	source := (&clientcredentials.Config{
+		ClientID:     c.ClientID,
+		ClientSecret: c.ClientSecret,
+		TokenURL:     "https://" + domain + "/oauth/token",
+		EndpointParams: url.Values{
+			"audience": {audience},
+		},
+	}).TokenSource(ctx)
+
+	if cacheDir == "" {
+		return source
+	}
+	return tokencache.New(cacheDir, tokencache.Key(domain, c.ClientID, audience), source)
+}
+
+// envIntDefaultFunc returns a default func reading an integer from the given
+// environment variable, or falling back to dv if it isn't set.
+func envIntDefaultFunc(k string, dv int) schema.SchemaDefaultFunc {
+	return func() (interface{}, error) {
+		v := os.Getenv(k)
+		if v == "" {
+			return dv, nil
+		}
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q for %s, expected an integer", v, k)
+		}
+		return i, nil
+	}
 }
 
 func Version() string {
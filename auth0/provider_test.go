@@ -4,6 +4,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/alexkappa/terraform-provider-auth0/auth0/internal/profile"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 
@@ -56,3 +57,24 @@ func TestProvider_debugDefaults(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateCredentials(t *testing.T) {
+	for _, test := range []struct {
+		credentials profile.Profile
+		err         bool
+	}{
+		{profile.Profile{Domain: "example.auth0.com", ClientID: "id", ClientSecret: "secret"}, false},
+		{profile.Profile{Domain: "login.example.com", ClientID: "id", ClientSecret: "secret", Audience: "https://example.auth0.com/api/v2/"}, false},
+		{profile.Profile{Domain: "example.auth0.com", APIToken: "token"}, false},
+		{profile.Profile{ClientID: "id", ClientSecret: "secret"}, true},
+		{profile.Profile{Domain: "example.auth0.com", ClientID: "id"}, true},
+		{profile.Profile{Domain: "example.auth0.com"}, true},
+		{profile.Profile{Domain: "example.auth0.com", APIToken: "token", ClientID: "id", ClientSecret: "secret"}, true},
+		{profile.Profile{Domain: "example.auth0.com", APIToken: "token", Audience: "https://example.auth0.com/api/v2/"}, true},
+	} {
+		err := validateCredentials(&test.credentials)
+		if test.err != (err != nil) {
+			t.Errorf("unexpected error for %+v: %v", test.credentials, err)
+		}
+	}
+}
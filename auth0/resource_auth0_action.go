@@ -0,0 +1,332 @@
+package auth0
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/management"
+)
+
+// action holds the code of an action, along with the version of it which is
+// deployed.
+type action struct {
+	ID                *string              `json:"id,omitempty"`
+	Name              *string              `json:"name,omitempty"`
+	SupportedTriggers []*actionTrigger     `json:"supported_triggers,omitempty"`
+	Code              *string              `json:"code,omitempty"`
+	Dependencies      *[]*actionDependency `json:"dependencies,omitempty"`
+	Runtime           *string              `json:"runtime,omitempty"`
+	Secrets           *[]*actionSecret     `json:"secrets,omitempty"`
+	Status            *string              `json:"status,omitempty"`
+	DeployedVersion   *actionVersion       `json:"deployed_version,omitempty"`
+}
+
+type actionTrigger struct {
+	ID      *string `json:"id,omitempty"`
+	Version *string `json:"version,omitempty"`
+}
+
+type actionDependency struct {
+	Name    *string `json:"name,omitempty"`
+	Version *string `json:"version,omitempty"`
+}
+
+type actionSecret struct {
+	Name  *string `json:"name,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
+
+type actionVersion struct {
+	ID *string `json:"id,omitempty"`
+}
+
+// actionTriggers are the triggers actions can be bound to.
+var actionTriggers = []string{
+	"post-login",
+	"credentials-exchange",
+	"pre-user-registration",
+	"post-user-registration",
+	"post-change-password",
+	"send-phone-message",
+}
+
+// Statuses an action goes through while it is being built.
+var actionBuildPending = []string{"pending", "building", "packaged", "retrying"}
+
+func newAction() *schema.Resource {
+	return &schema.Resource{
+
+		Create: createAction,
+		Read:   readAction,
+		Update: updateAction,
+		Delete: deleteAction,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the action",
+			},
+			"supported_triggers": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(actionTriggers, false),
+							Description:  "Trigger ID",
+						},
+						"version": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Trigger version",
+						},
+					},
+				},
+				Description: "The trigger the action is executed for",
+			},
+			"code": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The source code of the action",
+			},
+			"dependencies": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the npm package",
+						},
+						"version": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Version of the npm package",
+						},
+					},
+				},
+				Description: "npm dependencies of the action",
+			},
+			"runtime": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"node12",
+					"node16",
+					"node18",
+				}, false),
+				Description: "The Node runtime. Options include `node12`, `node16` and `node18`",
+			},
+			"secrets": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the secret",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: "Value of the secret",
+						},
+					},
+				},
+				Description: "Secrets available to the action at runtime",
+			},
+			"version_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the currently deployed version of the action",
+			},
+		},
+	}
+}
+
+func createAction(d *schema.ResourceData, m interface{}) error {
+	a := buildAction(d)
+	api := m.(*management.Management)
+	if err := api.Request("POST", api.URI("actions", "actions"), a); err != nil {
+		return err
+	}
+	d.SetId(auth0.StringValue(a.ID))
+
+	if err := deployAction(d, api, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+	return readAction(d, m)
+}
+
+func readAction(d *schema.ResourceData, m interface{}) error {
+	api := m.(*management.Management)
+
+	var a action
+	err := api.Request("GET", api.URI("actions", "actions", d.Id()), &a)
+	if err != nil {
+		if mErr, ok := err.(management.Error); ok {
+			if mErr.Status() == http.StatusNotFound {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	d.Set("name", a.Name)
+	d.Set("supported_triggers", flattenActionTriggers(a.SupportedTriggers))
+	d.Set("code", a.Code)
+	d.Set("dependencies", flattenActionDependencies(a.Dependencies))
+	d.Set("runtime", a.Runtime)
+	if a.DeployedVersion != nil {
+		d.Set("version_id", a.DeployedVersion.ID)
+	}
+	return nil
+}
+
+func updateAction(d *schema.ResourceData, m interface{}) error {
+	a := buildAction(d)
+	api := m.(*management.Management)
+	err := api.Request("PATCH", api.URI("actions", "actions", d.Id()), a)
+	if err != nil {
+		return err
+	}
+
+	if err := deployAction(d, api, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+	return readAction(d, m)
+}
+
+func deleteAction(d *schema.ResourceData, m interface{}) error {
+	api := m.(*management.Management)
+	err := api.Request("DELETE", api.URI("actions", "actions", d.Id()), nil)
+	if err != nil {
+		if mErr, ok := err.(management.Error); ok {
+			if mErr.Status() == http.StatusNotFound {
+				d.SetId("")
+				return nil
+			}
+		}
+	}
+	return err
+}
+
+// deployAction waits for the latest changes to the action to be built, then
+// deploys them. Actions can't be deployed, or bound to a trigger, before the
+// build finishes.
+func deployAction(d *schema.ResourceData, api *management.Management, timeout time.Duration) error {
+	wait := &resource.StateChangeConf{
+		Pending: actionBuildPending,
+		Target:  []string{"built"},
+		Timeout: timeout,
+		Refresh: func() (interface{}, string, error) {
+			var a action
+			err := api.Request("GET", api.URI("actions", "actions", d.Id()), &a)
+			if err != nil {
+				return nil, "", err
+			}
+			status := auth0.StringValue(a.Status)
+			if status == "failed" {
+				return nil, status, fmt.Errorf("failed to build action %q", d.Id())
+			}
+			return &a, status, nil
+		},
+	}
+	if _, err := wait.WaitForState(); err != nil {
+		return err
+	}
+
+	var v actionVersion
+	err := api.Request("POST", api.URI("actions", "actions", d.Id(), "deploy"), &v)
+	if err != nil {
+		return err
+	}
+	d.Set("version_id", v.ID)
+	return nil
+}
+
+func buildAction(d *schema.ResourceData) *action {
+	a := &action{
+		Name:    String(d, "name"),
+		Code:    String(d, "code"),
+		Runtime: String(d, "runtime"),
+	}
+
+	List(d, "supported_triggers").Elem(func(d ResourceData) {
+		a.SupportedTriggers = append(a.SupportedTriggers, &actionTrigger{
+			ID:      String(d, "id"),
+			Version: String(d, "version"),
+		})
+	})
+
+	dependencies := []*actionDependency{}
+	Set(d, "dependencies").Elem(func(d ResourceData) {
+		dependencies = append(dependencies, &actionDependency{
+			Name:    String(d, "name"),
+			Version: String(d, "version"),
+		})
+	})
+	a.Dependencies = &dependencies
+
+	// Secret values are write-only, so they are only sent when they change.
+	if d.IsNewResource() || d.HasChange("secrets") {
+		secrets := []*actionSecret{}
+		List(d, "secrets").Elem(func(d ResourceData) {
+			secrets = append(secrets, &actionSecret{
+				Name:  String(d, "name"),
+				Value: String(d, "value"),
+			})
+		})
+		a.Secrets = &secrets
+	}
+
+	return a
+}
+
+func flattenActionTriggers(triggers []*actionTrigger) []interface{} {
+	var v []interface{}
+	for _, t := range triggers {
+		v = append(v, map[string]interface{}{
+			"id":      auth0.StringValue(t.ID),
+			"version": auth0.StringValue(t.Version),
+		})
+	}
+	return v
+}
+
+func flattenActionDependencies(dependencies *[]*actionDependency) []interface{} {
+	if dependencies == nil {
+		return nil
+	}
+	var v []interface{}
+	for _, dep := range *dependencies {
+		v = append(v, map[string]interface{}{
+			"name":    auth0.StringValue(dep.Name),
+			"version": auth0.StringValue(dep.Version),
+		})
+	}
+	return v
+}
@@ -0,0 +1,84 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/alexkappa/terraform-provider-auth0/auth0/internal/random"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccAction(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(testAccActionCreate, rand),
+				Check: resource.ComposeTestCheckFunc(
+					random.TestCheckResourceAttr("auth0_action.my_action", "name", "Test Action {{.random}}", rand),
+					resource.TestCheckResourceAttr("auth0_action.my_action", "supported_triggers.0.id", "post-login"),
+					resource.TestCheckResourceAttr("auth0_action.my_action", "supported_triggers.0.version", "v2"),
+					resource.TestCheckResourceAttr("auth0_action.my_action", "runtime", "node16"),
+					resource.TestCheckResourceAttr("auth0_action.my_action", "dependencies.#", "0"),
+					resource.TestCheckResourceAttrSet("auth0_action.my_action", "version_id"),
+				),
+			},
+			{
+				Config: random.Template(testAccActionUpdate, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_action.my_action", "dependencies.#", "1"),
+					resource.TestCheckResourceAttr("auth0_action.my_action", "secrets.#", "1"),
+					resource.TestCheckResourceAttr("auth0_action.my_action", "secrets.0.name", "foo"),
+					resource.TestCheckResourceAttrSet("auth0_action.my_action", "version_id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccActionCreate = `
+
+resource auth0_action my_action {
+	name = "Test Action {{.random}}"
+	supported_triggers {
+		id = "post-login"
+		version = "v2"
+	}
+	runtime = "node16"
+	code = <<-EOT
+	exports.onExecutePostLogin = async (event, api) => {
+		console.log(event)
+	};
+	EOT
+}
+`
+
+const testAccActionUpdate = `
+
+resource auth0_action my_action {
+	name = "Test Action {{.random}}"
+	supported_triggers {
+		id = "post-login"
+		version = "v2"
+	}
+	runtime = "node16"
+	code = <<-EOT
+	exports.onExecutePostLogin = async (event, api) => {
+		console.log(require("lodash").capitalize(event.secrets.foo))
+	};
+	EOT
+	dependencies {
+		name = "lodash"
+		version = "4.17.21"
+	}
+	secrets {
+		name = "foo"
+		value = "bar"
+	}
+}
+`
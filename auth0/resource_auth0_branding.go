@@ -0,0 +1,293 @@
+package auth0
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"gopkg.in/auth0.v5/management"
+
+	v "github.com/alexkappa/terraform-provider-auth0/auth0/internal/validation"
+)
+
+func newBranding() *schema.Resource {
+	return &schema.Resource{
+
+		Create: createBranding,
+		Read:   readBranding,
+		Update: updateBranding,
+		Delete: deleteBranding,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"logo_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsURLWithScheme([]string{"https"}),
+				Description:  "URL of the logo",
+			},
+			"favicon_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsURLWithScheme([]string{"https"}),
+				Description:  "URL of the favicon",
+			},
+			"colors": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"primary": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "Primary (accent) color in hex format",
+						},
+						"page_background": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"colors.0.page_background_gradient"},
+							Description:   "Page background color in hex format",
+						},
+						"page_background_gradient": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"colors.0.page_background"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "linear-gradient",
+										ValidateFunc: validation.StringInSlice([]string{
+											"linear-gradient",
+										}, false),
+									},
+									"start": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"end": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"angle_deg": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(0, 360),
+									},
+								},
+							},
+							Description: "Page background gradient",
+						},
+					},
+				},
+			},
+			"font": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsURLWithScheme([]string{"https"}),
+							Description:  "URL of the custom font",
+						},
+					},
+				},
+			},
+			"universal_login": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"body": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: v.UniversalLoginTemplateContainsCorrectTags,
+							Description:  "The body of the Universal Login page template",
+						},
+					},
+				},
+				Description: "Custom Universal Login page template. Requires a custom domain",
+			},
+		},
+	}
+}
+
+func createBranding(d *schema.ResourceData, m interface{}) error {
+	d.SetId(resource.UniqueId())
+	return updateBranding(d, m)
+}
+
+func readBranding(d *schema.ResourceData, m interface{}) error {
+	api := m.(*management.Management)
+	b, err := api.Branding.Read()
+	if err != nil {
+		if mErr, ok := err.(management.Error); ok {
+			if mErr.Status() == http.StatusNotFound {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	d.Set("logo_url", b.LogoURL)
+	d.Set("favicon_url", b.FaviconURL)
+	d.Set("colors", flattenBrandingColors(b.Colors))
+	d.Set("font", flattenBrandingFont(b.Font))
+
+	ul, err := api.Branding.UniversalLogin()
+	if err != nil {
+		if mErr, ok := err.(management.Error); ok {
+			if mErr.Status() == http.StatusNotFound {
+				d.Set("universal_login", nil)
+				return nil
+			}
+		}
+		return err
+	}
+	d.Set("universal_login", flattenBrandingUniversalLogin(ul))
+
+	return nil
+}
+
+func updateBranding(d *schema.ResourceData, m interface{}) error {
+	b := buildBranding(d)
+	api := m.(*management.Management)
+	if err := api.Branding.Update(b); err != nil {
+		return err
+	}
+	if d.IsNewResource() || d.HasChange("universal_login") {
+		if err := updateBrandingUniversalLogin(d, api); err != nil {
+			return err
+		}
+	}
+	return readBranding(d, m)
+}
+
+func deleteBranding(d *schema.ResourceData, m interface{}) error {
+	if _, ok := d.GetOk("universal_login"); ok {
+		api := m.(*management.Management)
+		err := api.Branding.DeleteUniversalLogin()
+		if err != nil {
+			if mErr, ok := err.(management.Error); ok {
+				if mErr.Status() != http.StatusNotFound {
+					return err
+				}
+			} else {
+				return err
+			}
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+func buildBranding(d *schema.ResourceData) *management.Branding {
+	b := &management.Branding{
+		LogoURL:    String(d, "logo_url"),
+		FaviconURL: String(d, "favicon_url"),
+	}
+
+	List(d, "colors").Elem(func(d ResourceData) {
+		b.Colors = &management.BrandingColors{
+			Primary:        String(d, "primary"),
+			PageBackground: String(d, "page_background"),
+		}
+		List(d, "page_background_gradient").Elem(func(d ResourceData) {
+			b.Colors.PageBackgroundGradient = &management.BrandingPageBackgroundGradient{
+				Type:        String(d, "type"),
+				Start:       String(d, "start"),
+				End:         String(d, "end"),
+				AngleDegree: Int(d, "angle_deg"),
+			}
+		})
+	})
+
+	List(d, "font").Elem(func(d ResourceData) {
+		b.Font = &management.BrandingFont{
+			URL: String(d, "url"),
+		}
+	})
+
+	return b
+}
+
+func updateBrandingUniversalLogin(d *schema.ResourceData, api *management.Management) error {
+	var ul *management.BrandingUniversalLogin
+	List(d, "universal_login").Elem(func(d ResourceData) {
+		ul = &management.BrandingUniversalLogin{
+			Body: String(d, "body"),
+		}
+	})
+	if ul != nil {
+		return api.Branding.SetUniversalLogin(ul)
+	}
+	if d.IsNewResource() {
+		return nil
+	}
+	err := api.Branding.DeleteUniversalLogin()
+	if err != nil {
+		if mErr, ok := err.(management.Error); ok {
+			if mErr.Status() == http.StatusNotFound {
+				return nil
+			}
+		}
+	}
+	return err
+}
+
+func flattenBrandingColors(colors *management.BrandingColors) []interface{} {
+	m := make(map[string]interface{})
+	if colors != nil {
+		m["primary"] = colors.Primary
+		m["page_background"] = colors.PageBackground
+		if g := colors.PageBackgroundGradient; g != nil {
+			m["page_background_gradient"] = []interface{}{
+				map[string]interface{}{
+					"type":      g.Type,
+					"start":     g.Start,
+					"end":       g.End,
+					"angle_deg": g.AngleDegree,
+				},
+			}
+		}
+	}
+	return []interface{}{m}
+}
+
+func flattenBrandingFont(font *management.BrandingFont) []interface{} {
+	if font == nil {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"url": font.URL,
+		},
+	}
+}
+
+func flattenBrandingUniversalLogin(ul *management.BrandingUniversalLogin) []interface{} {
+	if ul == nil || ul.GetBody() == "" {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"body": ul.Body,
+		},
+	}
+}
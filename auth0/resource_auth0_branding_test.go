@@ -0,0 +1,73 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccBranding(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrandingCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_branding.my_brand", "logo_url", "https://mycompany.org/v1/logo.png"),
+					resource.TestCheckResourceAttr("auth0_branding.my_brand", "favicon_url", "https://mycompany.org/favicon.ico"),
+					resource.TestCheckResourceAttr("auth0_branding.my_brand", "colors.0.primary", "#0059d6"),
+					resource.TestCheckResourceAttr("auth0_branding.my_brand", "colors.0.page_background", "#000000"),
+					resource.TestCheckResourceAttr("auth0_branding.my_brand", "font.0.url", "https://mycompany.org/font/myfont.ttf"),
+				),
+			},
+			{
+				Config: testAccBrandingUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_branding.my_brand", "logo_url", "https://mycompany.org/v2/logo.png"),
+					resource.TestCheckResourceAttr("auth0_branding.my_brand", "colors.0.page_background", ""),
+					resource.TestCheckResourceAttr("auth0_branding.my_brand", "colors.0.page_background_gradient.0.start", "#000000"),
+					resource.TestCheckResourceAttr("auth0_branding.my_brand", "colors.0.page_background_gradient.0.end", "#ffffff"),
+					resource.TestCheckResourceAttr("auth0_branding.my_brand", "colors.0.page_background_gradient.0.angle_deg", "35"),
+				),
+			},
+		},
+	})
+}
+
+const testAccBrandingCreate = `
+
+resource "auth0_branding" "my_brand" {
+  logo_url = "https://mycompany.org/v1/logo.png"
+  favicon_url = "https://mycompany.org/favicon.ico"
+  colors {
+    primary = "#0059d6"
+    page_background = "#000000"
+  }
+  font {
+    url = "https://mycompany.org/font/myfont.ttf"
+  }
+}
+`
+
+const testAccBrandingUpdate = `
+
+resource "auth0_branding" "my_brand" {
+  logo_url = "https://mycompany.org/v2/logo.png"
+  favicon_url = "https://mycompany.org/favicon.ico"
+  colors {
+    primary = "#0059d6"
+    page_background_gradient {
+      start = "#000000"
+      end = "#ffffff"
+      angle_deg = 35
+    }
+  }
+  font {
+    url = "https://mycompany.org/font/myfont.ttf"
+  }
+}
+`
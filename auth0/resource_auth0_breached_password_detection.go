@@ -0,0 +1,127 @@
+package auth0
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"gopkg.in/auth0.v5/management"
+)
+
+// breachedPasswordDetection holds the breached password detection settings of
+// the tenant.
+type breachedPasswordDetection struct {
+	Enabled                    *bool         `json:"enabled,omitempty"`
+	Shields                    []interface{} `json:"shields,omitempty"`
+	AdminNotificationFrequency []interface{} `json:"admin_notification_frequency,omitempty"`
+	Method                     *string       `json:"method,omitempty"`
+}
+
+func newBreachedPasswordDetection() *schema.Resource {
+	return &schema.Resource{
+
+		Create: createBreachedPasswordDetection,
+		Read:   readBreachedPasswordDetection,
+		Update: updateBreachedPasswordDetection,
+		Delete: deleteBreachedPasswordDetection,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether breached password detection is enabled",
+			},
+			"shields": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"block", "user_notification", "admin_notification",
+					}, false),
+				},
+				Description: "Action to take when a breached password is detected. " +
+					"Options include `block`, `user_notification` and `admin_notification`",
+			},
+			"admin_notification_frequency": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"immediately", "daily", "weekly", "monthly",
+					}, false),
+				},
+				Description: "When `admin_notification` is enabled, determines how often email notifications are sent. " +
+					"Options include `immediately`, `daily`, `weekly` and `monthly`",
+			},
+			"method": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"standard", "enhanced",
+				}, false),
+				Description: "The subscription level for breached password detection. " +
+					"Options include `standard` and `enhanced`",
+			},
+		},
+	}
+}
+
+func createBreachedPasswordDetection(d *schema.ResourceData, m interface{}) error {
+	d.SetId(resource.UniqueId())
+	return updateBreachedPasswordDetection(d, m)
+}
+
+func readBreachedPasswordDetection(d *schema.ResourceData, m interface{}) error {
+	api := m.(*management.Management)
+	var b breachedPasswordDetection
+	err := api.Request("GET", api.URI("attack-protection", "breached-password-detection"), &b)
+	if err != nil {
+		if mErr, ok := err.(management.Error); ok {
+			if mErr.Status() == http.StatusNotFound {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+	d.Set("enabled", b.Enabled)
+	d.Set("shields", b.Shields)
+	d.Set("admin_notification_frequency", b.AdminNotificationFrequency)
+	d.Set("method", b.Method)
+	return nil
+}
+
+func updateBreachedPasswordDetection(d *schema.ResourceData, m interface{}) error {
+	b := buildBreachedPasswordDetection(d)
+	api := m.(*management.Management)
+	err := api.Request("PATCH", api.URI("attack-protection", "breached-password-detection"), b)
+	if err != nil {
+		return err
+	}
+	return readBreachedPasswordDetection(d, m)
+}
+
+func deleteBreachedPasswordDetection(d *schema.ResourceData, m interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+func buildBreachedPasswordDetection(d *schema.ResourceData) *breachedPasswordDetection {
+	return &breachedPasswordDetection{
+		Enabled:                    Bool(d, "enabled"),
+		Shields:                    Set(d, "shields", IsNewResource(), HasChange()).List(),
+		AdminNotificationFrequency: Set(d, "admin_notification_frequency", IsNewResource(), HasChange()).List(),
+		Method:                     String(d, "method"),
+	}
+}
@@ -0,0 +1,68 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccBreachedPasswordDetection(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBreachedPasswordDetectionCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_breached_password_detection.my_detection", "enabled", "true"),
+					resource.TestCheckResourceAttr("auth0_breached_password_detection.my_detection", "shields.#", "1"),
+					resource.TestCheckResourceAttr("auth0_breached_password_detection.my_detection", "method", "standard"),
+				),
+			},
+			{
+				Config: testAccBreachedPasswordDetectionUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_breached_password_detection.my_detection", "shields.#", "2"),
+					resource.TestCheckResourceAttr("auth0_breached_password_detection.my_detection", "admin_notification_frequency.#", "2"),
+				),
+			},
+			{
+				Config: testAccBreachedPasswordDetectionWithoutShields,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_breached_password_detection.without_shields", "enabled", "true"),
+					resource.TestCheckResourceAttr("auth0_breached_password_detection.without_shields", "shields.#", "2"),
+					resource.TestCheckResourceAttr("auth0_breached_password_detection.without_shields", "admin_notification_frequency.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+const testAccBreachedPasswordDetectionCreate = `
+
+resource "auth0_breached_password_detection" "my_detection" {
+  enabled = true
+  shields = [ "block" ]
+  method = "standard"
+}
+`
+
+const testAccBreachedPasswordDetectionUpdate = `
+
+resource "auth0_breached_password_detection" "my_detection" {
+  enabled = true
+  shields = [ "block", "admin_notification" ]
+  admin_notification_frequency = [ "daily", "weekly" ]
+  method = "standard"
+}
+`
+
+const testAccBreachedPasswordDetectionWithoutShields = `
+
+resource "auth0_breached_password_detection" "without_shields" {
+  enabled = true
+}
+`
@@ -0,0 +1,133 @@
+package auth0
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"gopkg.in/auth0.v5/management"
+)
+
+// bruteForceProtection holds the brute-force protection settings of the
+// tenant.
+type bruteForceProtection struct {
+	Enabled     *bool         `json:"enabled,omitempty"`
+	Shields     []interface{} `json:"shields,omitempty"`
+	Allowlist   []interface{} `json:"allowlist"`
+	Mode        *string       `json:"mode,omitempty"`
+	MaxAttempts *int          `json:"max_attempts,omitempty"`
+}
+
+func newBruteForceProtection() *schema.Resource {
+	return &schema.Resource{
+
+		Create: createBruteForceProtection,
+		Read:   readBruteForceProtection,
+		Update: updateBruteForceProtection,
+		Delete: deleteBruteForceProtection,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether brute-force protection is enabled",
+			},
+			"shields": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"block", "user_notification",
+					}, false),
+				},
+				Description: "Action to take when a brute-force attack is detected. " +
+					"Options include `block` and `user_notification`",
+			},
+			"allowlist": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.Any(validation.IsIPAddress, validation.IsCIDR),
+				},
+				Description: "IP addresses or CIDR ranges that are never blocked",
+			},
+			"mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"count_per_identifier_and_ip", "count_per_identifier",
+				}, false),
+				Description: "Whether failed attempts are counted per identifier and IP address, or per identifier only. " +
+					"Options include `count_per_identifier_and_ip` and `count_per_identifier`",
+			},
+			"max_attempts": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+				Description:  "Maximum number of unsuccessful attempts before the account is blocked",
+			},
+		},
+	}
+}
+
+func createBruteForceProtection(d *schema.ResourceData, m interface{}) error {
+	d.SetId(resource.UniqueId())
+	return updateBruteForceProtection(d, m)
+}
+
+func readBruteForceProtection(d *schema.ResourceData, m interface{}) error {
+	api := m.(*management.Management)
+	var b bruteForceProtection
+	err := api.Request("GET", api.URI("attack-protection", "brute-force-protection"), &b)
+	if err != nil {
+		if mErr, ok := err.(management.Error); ok {
+			if mErr.Status() == http.StatusNotFound {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+	d.Set("enabled", b.Enabled)
+	d.Set("shields", b.Shields)
+	d.Set("allowlist", b.Allowlist)
+	d.Set("mode", b.Mode)
+	d.Set("max_attempts", b.MaxAttempts)
+	return nil
+}
+
+func updateBruteForceProtection(d *schema.ResourceData, m interface{}) error {
+	b := buildBruteForceProtection(d)
+	api := m.(*management.Management)
+	err := api.Request("PATCH", api.URI("attack-protection", "brute-force-protection"), b)
+	if err != nil {
+		return err
+	}
+	return readBruteForceProtection(d, m)
+}
+
+func deleteBruteForceProtection(d *schema.ResourceData, m interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+func buildBruteForceProtection(d *schema.ResourceData) *bruteForceProtection {
+	return &bruteForceProtection{
+		Enabled:     Bool(d, "enabled"),
+		Shields:     Set(d, "shields", IsNewResource(), HasChange()).List(),
+		Allowlist:   Set(d, "allowlist").List(),
+		Mode:        String(d, "mode"),
+		MaxAttempts: Int(d, "max_attempts"),
+	}
+}
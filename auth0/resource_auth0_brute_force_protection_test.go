@@ -0,0 +1,89 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccBruteForceProtection(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBruteForceProtectionCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_brute_force_protection.my_protection", "enabled", "true"),
+					resource.TestCheckResourceAttr("auth0_brute_force_protection.my_protection", "shields.#", "2"),
+					resource.TestCheckResourceAttr("auth0_brute_force_protection.my_protection", "mode", "count_per_identifier_and_ip"),
+					resource.TestCheckResourceAttr("auth0_brute_force_protection.my_protection", "max_attempts", "10"),
+				),
+			},
+			{
+				Config: testAccBruteForceProtectionUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_brute_force_protection.my_protection", "shields.#", "1"),
+					resource.TestCheckResourceAttr("auth0_brute_force_protection.my_protection", "allowlist.#", "2"),
+					resource.TestCheckResourceAttr("auth0_brute_force_protection.my_protection", "mode", "count_per_identifier"),
+					resource.TestCheckResourceAttr("auth0_brute_force_protection.my_protection", "max_attempts", "5"),
+				),
+			},
+			{
+				Config: testAccBruteForceProtectionClearAllowlist,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_brute_force_protection.my_protection", "shields.#", "1"),
+					resource.TestCheckResourceAttr("auth0_brute_force_protection.my_protection", "allowlist.#", "0"),
+				),
+			},
+			{
+				Config: testAccBruteForceProtectionWithoutShields,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_brute_force_protection.without_shields", "enabled", "true"),
+					resource.TestCheckResourceAttr("auth0_brute_force_protection.without_shields", "shields.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccBruteForceProtectionCreate = `
+
+resource "auth0_brute_force_protection" "my_protection" {
+  enabled = true
+  shields = [ "block", "user_notification" ]
+  mode = "count_per_identifier_and_ip"
+  max_attempts = 10
+}
+`
+
+const testAccBruteForceProtectionUpdate = `
+
+resource "auth0_brute_force_protection" "my_protection" {
+  enabled = true
+  shields = [ "block" ]
+  allowlist = [ "127.0.0.1", "10.0.0.0/8" ]
+  mode = "count_per_identifier"
+  max_attempts = 5
+}
+`
+
+const testAccBruteForceProtectionClearAllowlist = `
+
+resource "auth0_brute_force_protection" "my_protection" {
+  enabled = true
+  shields = [ "block" ]
+  mode = "count_per_identifier"
+  max_attempts = 5
+}
+`
+
+const testAccBruteForceProtectionWithoutShields = `
+
+resource "auth0_brute_force_protection" "without_shields" {
+  enabled = true
+}
+`
@@ -406,8 +406,14 @@ func newClient() *schema.Resource {
 					"none",
 					"client_secret_post",
 					"client_secret_basic",
+					"private_key_jwt",
 				}, false),
 			},
+			"private_key_jwt_credentials": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"client_metadata": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -544,7 +550,8 @@ func createClient(d *schema.ResourceData, m interface{}) error {
 
 func readClient(d *schema.ResourceData, m interface{}) error {
 	api := m.(*management.Management)
-	c, err := api.Client.Read(d.Id())
+	var c clientWithAuthentication
+	err := api.Request("GET", api.URI("clients", d.Id()), &c)
 	if err != nil {
 		if mErr, ok := err.(management.Error); ok {
 			if mErr.Status() == http.StatusNotFound {
@@ -578,6 +585,11 @@ func readClient(d *schema.ResourceData, m interface{}) error {
 	d.Set("custom_login_page_preview", c.CustomLoginPagePreview)
 	d.Set("form_template", c.FormTemplate)
 	d.Set("token_endpoint_auth_method", c.TokenEndpointAuthMethod)
+	d.Set("private_key_jwt_credentials", nil)
+	if credentials := c.ClientAuthenticationMethods.privateKeyJWTCredentials(); credentials != nil {
+		d.Set("token_endpoint_auth_method", "private_key_jwt")
+		d.Set("private_key_jwt_credentials", credentials)
+	}
 	d.Set("jwt_configuration", flattenClientJwtConfiguration(c.JWTConfiguration))
 	d.Set("refresh_token", flattenClientRefreshTokenConfiguration(c.RefreshToken))
 	d.Set("encryption_key", c.EncryptionKey)
@@ -592,6 +604,9 @@ func readClient(d *schema.ResourceData, m interface{}) error {
 func updateClient(d *schema.ResourceData, m interface{}) error {
 	c := expandClient(d)
 	api := m.(*management.Management)
+	if err := updateClientAuthenticationMethod(d, api); err != nil {
+		return err
+	}
 	if clientHasChange(c) {
 		err := api.Client.Update(d.Id(), c)
 		if err != nil {
@@ -648,6 +663,12 @@ func expandClient(d *schema.ResourceData) *management.Client {
 		InitiateLoginURI:               String(d, "initiate_login_uri"),
 	}
 
+	// Clients using private_key_jwt are switched to and from it by the
+	// auth0_client_credentials resource and updateClientAuthenticationMethod.
+	if o, n := d.GetChange("token_endpoint_auth_method"); o == "private_key_jwt" || n == "private_key_jwt" {
+		c.TokenEndpointAuthMethod = nil
+	}
+
 	List(d, "refresh_token", IsNewResource(), HasChange()).Elem(func(d ResourceData) {
 		c.RefreshToken = &management.ClientRefreshToken{
 			RotationType:              String(d, "rotation_type"),
@@ -797,6 +818,19 @@ func rotateClientSecret(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
+// updateClientAuthenticationMethod switches a client away from
+// private_key_jwt. The Management API rejects a token_endpoint_auth_method
+// while client_authentication_methods is set, so both are changed together.
+func updateClientAuthenticationMethod(d *schema.ResourceData, api *management.Management) error {
+	o, n := d.GetChange("token_endpoint_auth_method")
+	if o != "private_key_jwt" || n == "private_key_jwt" {
+		return nil
+	}
+	return api.Request("PATCH", api.URI("clients", d.Id()), &clientAuthentication{
+		TokenEndpointAuthMethod: auth0.String(n.(string)),
+	})
+}
+
 func clientHasChange(c *management.Client) bool {
 	return c.String() != "{}"
 }
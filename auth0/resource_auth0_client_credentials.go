@@ -0,0 +1,313 @@
+package auth0
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/mutexkv"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/management"
+)
+
+// clientCredential is a public key a client authenticates with when using
+// private_key_jwt.
+type clientCredential struct {
+	ID             *string `json:"id,omitempty"`
+	CredentialType *string `json:"credential_type,omitempty"`
+	Name           *string `json:"name,omitempty"`
+	KeyID          *string `json:"kid,omitempty"`
+	Algorithm      *string `json:"alg,omitempty"`
+	PEM            *string `json:"pem,omitempty"`
+	CreatedAt      *string `json:"created_at,omitempty"`
+	ExpiresAt      *string `json:"expires_at,omitempty"`
+}
+
+// clientAuthentication is used to switch a client between private_key_jwt and
+// the other authentication methods. Both fields are always sent, as the
+// Management API only accepts one of them being set at a time.
+type clientAuthentication struct {
+	TokenEndpointAuthMethod     *string                      `json:"token_endpoint_auth_method"`
+	ClientAuthenticationMethods *clientAuthenticationMethods `json:"client_authentication_methods"`
+}
+
+type clientAuthenticationMethods struct {
+	PrivateKeyJWT *clientPrivateKeyJWT `json:"private_key_jwt,omitempty"`
+}
+
+// clientWithAuthentication is used to read a client along with its
+// private_key_jwt credentials, which management.Client lacks, in a single
+// request.
+type clientWithAuthentication struct {
+	management.Client
+	ClientAuthenticationMethods *clientAuthenticationMethods `json:"client_authentication_methods,omitempty"`
+}
+
+type clientPrivateKeyJWT struct {
+	Credentials []*clientCredentialReference `json:"credentials"`
+}
+
+type clientCredentialReference struct {
+	ID *string `json:"id"`
+}
+
+// privateKeyJWTCredentials returns the IDs of the credentials the client
+// authenticates with when using private_key_jwt.
+func (m *clientAuthenticationMethods) privateKeyJWTCredentials() []string {
+	if m == nil || m.PrivateKeyJWT == nil {
+		return nil
+	}
+	var ids []string
+	for _, c := range m.PrivateKeyJWT.Credentials {
+		ids = append(ids, auth0.StringValue(c.ID))
+	}
+	return ids
+}
+
+// clientCredentialMutex serializes changes to the private_key_jwt credentials
+// of a client, as each change is a read-modify-write of the whole list.
+var clientCredentialMutex = mutexkv.NewMutexKV()
+
+func newClientCredentials() *schema.Resource {
+	return &schema.Resource{
+
+		Create: createClientCredentials,
+		Read:   readClientCredentials,
+		Update: updateClientCredentials,
+		Delete: deleteClientCredentials,
+		Importer: &schema.ResourceImporter{
+			State: importClientCredentials,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"client_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the client the credential belongs to",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Friendly name of the credential",
+			},
+			"pem": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "PEM-formatted public key or X.509 certificate",
+			},
+			"algorithm": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "RS256",
+				ValidateFunc: validation.StringInSlice([]string{
+					"RS256",
+					"RS384",
+					"PS256",
+				}, false),
+				Description: "Algorithm used to sign client assertions. Options include `RS256`, `RS384` and `PS256`",
+			},
+			"expires_at": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "Date and time after which the credential can no longer be used, in RFC 3339 format",
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Key ID of the credential, derived from the public key",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date and time the credential was created",
+			},
+			"previous_token_endpoint_auth_method": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Authentication method the client used before this credential switched it to " +
+					"private_key_jwt, which is restored when the last credential is removed",
+			},
+		},
+	}
+}
+
+func createClientCredentials(d *schema.ResourceData, m interface{}) error {
+	clientID := d.Get("client_id").(string)
+
+	c := &clientCredential{
+		CredentialType: auth0.String("public_key"),
+		Name:           String(d, "name"),
+		PEM:            String(d, "pem"),
+		Algorithm:      String(d, "algorithm"),
+		ExpiresAt:      String(d, "expires_at"),
+	}
+
+	api := m.(*management.Management)
+	err := api.Request("POST", api.URI("clients", clientID, "credentials"), c)
+	if err != nil {
+		return err
+	}
+	d.SetId(auth0.StringValue(c.ID))
+
+	previous, err := attachClientCredential(api, clientID, d.Id(), true, "")
+	if err != nil {
+		return err
+	}
+	d.Set("previous_token_endpoint_auth_method", previous)
+
+	return readClientCredentials(d, m)
+}
+
+func readClientCredentials(d *schema.ResourceData, m interface{}) error {
+	clientID := d.Get("client_id").(string)
+
+	api := m.(*management.Management)
+
+	var c clientCredential
+	err := api.Request("GET", api.URI("clients", clientID, "credentials", d.Id()), &c)
+	if err != nil {
+		if mErr, ok := err.(management.Error); ok {
+			if mErr.Status() == http.StatusNotFound {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	d.Set("name", c.Name)
+	d.Set("algorithm", c.Algorithm)
+	d.Set("expires_at", c.ExpiresAt)
+	d.Set("key_id", c.KeyID)
+	d.Set("created_at", c.CreatedAt)
+	return nil
+}
+
+func updateClientCredentials(d *schema.ResourceData, m interface{}) error {
+	clientID := d.Get("client_id").(string)
+
+	api := m.(*management.Management)
+	err := api.Request("PATCH", api.URI("clients", clientID, "credentials", d.Id()), &clientCredential{
+		ExpiresAt: String(d, "expires_at"),
+	})
+	if err != nil {
+		return err
+	}
+
+	return readClientCredentials(d, m)
+}
+
+func deleteClientCredentials(d *schema.ResourceData, m interface{}) error {
+	clientID := d.Get("client_id").(string)
+
+	// A credential can't be deleted while the client authenticates with it.
+	api := m.(*management.Management)
+	_, err := attachClientCredential(api, clientID, d.Id(), false,
+		d.Get("previous_token_endpoint_auth_method").(string))
+	if err == nil {
+		err = api.Request("DELETE", api.URI("clients", clientID, "credentials", d.Id()), nil)
+	}
+	if err != nil {
+		if mErr, ok := err.(management.Error); ok {
+			if mErr.Status() == http.StatusNotFound {
+				d.SetId("")
+				return nil
+			}
+		}
+	}
+	return err
+}
+
+func importClientCredentials(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	clientID, credentialID, err := parseClientCredentialsID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	d.Set("client_id", clientID)
+	d.SetId(credentialID)
+	return []*schema.ResourceData{d}, nil
+}
+
+// readClientAuthentication reads the authentication method of a client,
+// including the private_key_jwt credentials, which management.Client lacks.
+func readClientAuthentication(api *management.Management, clientID string) (*clientAuthentication, error) {
+	var a clientAuthentication
+	err := api.Request("GET", api.URI("clients", clientID), &a,
+		management.IncludeFields("token_endpoint_auth_method", "client_authentication_methods"))
+	if err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// attachClientCredential adds the credential to, or removes it from, the
+// credentials the client authenticates with. Attaching the first credential
+// switches the client to private_key_jwt, and returns the authentication
+// method it used before. Detaching the last one switches it back to the given
+// method, or to client_secret_post if it isn't known.
+func attachClientCredential(api *management.Management, clientID, credentialID string, attach bool, restore string) (previous string, err error) {
+	clientCredentialMutex.Lock(clientID)
+	defer clientCredentialMutex.Unlock(clientID)
+
+	a, err := readClientAuthentication(api, clientID)
+	if err != nil {
+		return "", err
+	}
+	attached := a.ClientAuthenticationMethods.privateKeyJWTCredentials()
+	if len(attached) == 0 {
+		previous = auth0.StringValue(a.TokenEndpointAuthMethod)
+	}
+
+	var credentials []*clientCredentialReference
+	for _, id := range attached {
+		if id != credentialID {
+			credentials = append(credentials, &clientCredentialReference{ID: auth0.String(id)})
+		}
+	}
+	if attach {
+		credentials = append(credentials, &clientCredentialReference{ID: auth0.String(credentialID)})
+	}
+
+	if len(credentials) == 0 {
+		if len(attached) == 0 {
+			return previous, nil
+		}
+		if restore == "" || restore == "private_key_jwt" {
+			restore = "client_secret_post"
+		}
+		a = &clientAuthentication{
+			TokenEndpointAuthMethod: auth0.String(restore),
+		}
+	} else {
+		a = &clientAuthentication{
+			ClientAuthenticationMethods: &clientAuthenticationMethods{
+				PrivateKeyJWT: &clientPrivateKeyJWT{
+					Credentials: credentials,
+				},
+			},
+		}
+	}
+
+	return previous, api.Request("PATCH", api.URI("clients", clientID), a)
+}
+
+// parseClientCredentialsID splits the ID used to import an
+// auth0_client_credentials resource, formatted as client_id:credential_id,
+// into its parts.
+func parseClientCredentialsID(id string) (clientID, credentialID string, err error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid client credentials ID %q, expected format client_id:credential_id", id)
+	}
+	return parts[0], parts[1], nil
+}
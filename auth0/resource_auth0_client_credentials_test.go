@@ -0,0 +1,189 @@
+package auth0
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/alexkappa/terraform-provider-auth0/auth0/internal/random"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/management"
+)
+
+func TestAccClientCredentials(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(testAccClientCredentialsCreate, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_client_credentials.my_key", "algorithm", "RS256"),
+					resource.TestCheckResourceAttr("auth0_client_credentials.my_key", "expires_at", "2050-01-01T00:00:00.000Z"),
+					resource.TestCheckResourceAttrSet("auth0_client_credentials.my_key", "key_id"),
+					resource.TestCheckResourceAttrPair("auth0_client_credentials.my_key", "client_id", "auth0_client.my_client", "id"),
+					resource.TestCheckResourceAttr("auth0_client_credentials.my_key", "previous_token_endpoint_auth_method", "client_secret_post"),
+				),
+			},
+			{
+				Config: random.Template(testAccClientCredentialsCreate+testAccClientCredentialsClient, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.auth0_client.my_client", "token_endpoint_auth_method", "private_key_jwt"),
+					resource.TestCheckResourceAttr("data.auth0_client.my_client", "private_key_jwt_credentials.#", "1"),
+					resource.TestCheckResourceAttrPair("data.auth0_client.my_client", "private_key_jwt_credentials.0", "auth0_client_credentials.my_key", "id"),
+				),
+			},
+			{
+				ResourceName:      "auth0_client_credentials.my_key",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["auth0_client_credentials.my_key"]
+					return fmt.Sprintf("%s:%s", rs.Primary.Attributes["client_id"], rs.Primary.ID), nil
+				},
+				ImportStateVerifyIgnore: []string{"pem", "previous_token_endpoint_auth_method"},
+			},
+		},
+	})
+}
+
+const testAccClientCredentialsCreate = `
+
+resource auth0_client my_client {
+	name = "Acceptance Test - Client Credentials - {{.random}}"
+	app_type = "non_interactive"
+	token_endpoint_auth_method = "private_key_jwt"
+}
+
+resource auth0_client_credentials my_key {
+	client_id = auth0_client.my_client.id
+	name = "Acceptance Test - {{.random}}"
+	expires_at = "2050-01-01T00:00:00.000Z"
+	pem = <<EOF
+-----BEGIN PUBLIC KEY-----
+MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEA467HY5q+QPgNl5XDH+mL
+sxwPBk61GUHwAWzcvMc44fyxiMU1AUV7sAo5W6hQPI8Ti1Gw3pB7pyOzHibgjAQQ
+oIDZ845GU7f6AOaVEzrK8z+8w1xE6X78SbE2N725uSM/js4diIWA8nLEvJQOaSnT
+hO0r55slbrpO+BCnlVtdHQPuLTabutGq2zmKcPTgCCIseT3dwaKOleAOJMyORbIf
+fbehWcnNld6wX8zg7ymQm+u88EYir++5bZYNubCdNLnpnKOFTyXFi6GfeOyh7dTh
+aiPcvaoGU0RFvTvB3fKJWAkYGk68qNrtdeSDANjsWy1sfq8THYC8oL2ZeaDGAIcV
+0QIDAQAB
+-----END PUBLIC KEY-----
+EOF
+}
+`
+
+const testAccClientCredentialsClient = `
+
+data auth0_client my_client {
+	client_id = auth0_client_credentials.my_key.client_id
+}
+`
+
+func TestParseClientCredentialsID(t *testing.T) {
+	for _, test := range []struct {
+		id           string
+		clientID     string
+		credentialID string
+		err          bool
+	}{
+		{"abc123:cred_456", "abc123", "cred_456", false},
+		{"abc123", "", "", true},
+		{"abc123:", "", "", true},
+		{"abc:123:cred_456", "", "", true},
+	} {
+		clientID, credentialID, err := parseClientCredentialsID(test.id)
+		if test.err != (err != nil) {
+			t.Errorf("unexpected error for %q: %v", test.id, err)
+		}
+		if clientID != test.clientID || credentialID != test.credentialID {
+			t.Errorf("expected %q to be parsed to (%q, %q), got (%q, %q)",
+				test.id, test.clientID, test.credentialID, clientID, credentialID)
+		}
+	}
+}
+
+func TestAttachClientCredentialRestoresAuthMethod(t *testing.T) {
+	client := &clientAuthentication{
+		TokenEndpointAuthMethod: auth0.String("client_secret_basic"),
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/clients/abc123" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Method == "PATCH" {
+			client = &clientAuthentication{}
+			if err := json.NewDecoder(r.Body).Decode(client); err != nil {
+				t.Error(err)
+			}
+		}
+		json.NewEncoder(w).Encode(client)
+	}))
+	defer server.Close()
+
+	api, err := management.New(strings.TrimPrefix(server.URL, "http://"),
+		management.WithInsecure(),
+		management.WithStaticToken("token"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	previous, err := attachClientCredential(api, "abc123", "cred_1", true, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if previous != "client_secret_basic" {
+		t.Errorf("expected the previous method to be client_secret_basic, got %q", previous)
+	}
+	if client.TokenEndpointAuthMethod != nil || len(client.ClientAuthenticationMethods.privateKeyJWTCredentials()) != 1 {
+		t.Errorf("expected the client to use the credential, got %+v", client)
+	}
+
+	if _, err := attachClientCredential(api, "abc123", "cred_2", true, ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := attachClientCredential(api, "abc123", "cred_2", false, ""); err != nil {
+		t.Fatal(err)
+	}
+	if credentials := client.ClientAuthenticationMethods.privateKeyJWTCredentials(); len(credentials) != 1 || credentials[0] != "cred_1" {
+		t.Errorf("expected the client to use cred_1 only, got %v", credentials)
+	}
+
+	if _, err := attachClientCredential(api, "abc123", "cred_1", false, previous); err != nil {
+		t.Fatal(err)
+	}
+	if method := auth0.StringValue(client.TokenEndpointAuthMethod); method != "client_secret_basic" {
+		t.Errorf("expected the client to be switched back to client_secret_basic, got %q", method)
+	}
+}
+
+func TestClientWithAuthentication(t *testing.T) {
+	var c clientWithAuthentication
+	err := json.Unmarshal([]byte(`{
+		"client_id": "abc123",
+		"name": "My App",
+		"client_authentication_methods": {
+			"private_key_jwt": {"credentials": [{"id": "cred_1"}]}
+		}
+	}`), &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.GetClientID() != "abc123" || c.GetName() != "My App" {
+		t.Errorf("expected the client to be decoded, got %s", c.Client.String())
+	}
+	if credentials := c.ClientAuthenticationMethods.privateKeyJWTCredentials(); len(credentials) != 1 || credentials[0] != "cred_1" {
+		t.Errorf("expected the credentials to be decoded, got %v", credentials)
+	}
+}
@@ -0,0 +1,196 @@
+package auth0
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/mutexkv"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"gopkg.in/auth0.v5/management"
+)
+
+// connectionClientMutex serializes changes to the enabled clients of a
+// connection, as each change is a read-modify-write of the whole list.
+var connectionClientMutex = mutexkv.NewMutexKV()
+
+// connectionEnabledClients is used to update only the enabled clients of a
+// connection. Unlike management.Connection it also sends an empty list, which
+// is needed to disable the last client.
+type connectionEnabledClients struct {
+	EnabledClients []interface{} `json:"enabled_clients"`
+}
+
+func newConnectionClient() *schema.Resource {
+	return &schema.Resource{
+
+		Create: createConnectionClient,
+		Read:   readConnectionClient,
+		Delete: deleteConnectionClient,
+		Importer: &schema.ResourceImporter{
+			State: importConnectionClient,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"connection_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the connection on which to enable the client",
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the client for which the connection is enabled",
+			},
+		},
+	}
+}
+
+func createConnectionClient(d *schema.ResourceData, m interface{}) error {
+	connectionID := d.Get("connection_id").(string)
+	clientID := d.Get("client_id").(string)
+
+	api := m.(*management.Management)
+	err := enableConnectionClient(api, connectionID, clientID, true, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+	d.SetId(connectionID + ":" + clientID)
+
+	return readConnectionClient(d, m)
+}
+
+func readConnectionClient(d *schema.ResourceData, m interface{}) error {
+	connectionID, clientID, err := parseConnectionClientID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	api := m.(*management.Management)
+	c, err := api.Connection.Read(connectionID)
+	if err != nil {
+		if mErr, ok := err.(management.Error); ok {
+			if mErr.Status() == http.StatusNotFound {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	// The connection exists, but is no longer enabled for the client.
+	if _, enabled := toggleConnectionClient(c.EnabledClients, clientID, false); !enabled {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("connection_id", connectionID)
+	d.Set("client_id", clientID)
+	return nil
+}
+
+func deleteConnectionClient(d *schema.ResourceData, m interface{}) error {
+	api := m.(*management.Management)
+	err := enableConnectionClient(api,
+		d.Get("connection_id").(string),
+		d.Get("client_id").(string),
+		false,
+		d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		if mErr, ok := err.(management.Error); ok {
+			if mErr.Status() == http.StatusNotFound {
+				d.SetId("")
+				return nil
+			}
+		}
+	}
+	return err
+}
+
+func importConnectionClient(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	connectionID, clientID, err := parseConnectionClientID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	d.Set("connection_id", connectionID)
+	d.Set("client_id", clientID)
+	return []*schema.ResourceData{d}, nil
+}
+
+// enableConnectionClient enables or disables a single client on a connection,
+// leaving the other enabled clients untouched. As the Management API only
+// allows replacing the whole list, the change is verified after it's made, and
+// retried if it was overwritten by a concurrent modification.
+func enableConnectionClient(api *management.Management, connectionID, clientID string, enable bool, timeout time.Duration) error {
+	connectionClientMutex.Lock(connectionID)
+	defer connectionClientMutex.Unlock(connectionID)
+
+	return resource.Retry(timeout, func() *resource.RetryError {
+		c, err := api.Connection.Read(connectionID)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		clients, changed := toggleConnectionClient(c.EnabledClients, clientID, enable)
+		if !changed {
+			return nil
+		}
+
+		err = api.Request("PATCH", api.URI("connections", connectionID), &connectionEnabledClients{
+			EnabledClients: clients,
+		})
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		c, err = api.Connection.Read(connectionID)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if _, changed := toggleConnectionClient(c.EnabledClients, clientID, enable); changed {
+			return resource.RetryableError(fmt.Errorf("enabled clients of connection %q were modified concurrently", connectionID))
+		}
+		return nil
+	})
+}
+
+// toggleConnectionClient adds the client to, or removes it from, the list of
+// enabled clients. It reports whether the list had to be changed, so calling it
+// with enable set to false also tells whether the client is enabled.
+func toggleConnectionClient(clients []interface{}, clientID string, enable bool) ([]interface{}, bool) {
+	toggled := make([]interface{}, 0, len(clients)+1)
+	var found bool
+	for _, c := range clients {
+		if c == clientID {
+			found = true
+			if !enable {
+				continue
+			}
+		}
+		toggled = append(toggled, c)
+	}
+	if enable && !found {
+		toggled = append(toggled, clientID)
+	}
+	return toggled, enable != found
+}
+
+// parseConnectionClientID splits the ID of an auth0_connection_client
+// resource, formatted as connection_id:client_id, into its parts.
+func parseConnectionClientID(id string) (connectionID, clientID string, err error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid connection client ID %q, expected format connection_id:client_id", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,123 @@
+package auth0
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/alexkappa/terraform-provider-auth0/auth0/internal/random"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccConnectionClient(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(testAccConnectionClient, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("auth0_connection_client.first", "client_id", "auth0_client.first", "id"),
+					resource.TestCheckResourceAttrPair("auth0_connection_client.second", "client_id", "auth0_client.second", "id"),
+					resource.TestCheckResourceAttrPair("auth0_connection_client.first", "connection_id", "auth0_connection.shared", "id"),
+				),
+			},
+			{
+				Config: random.Template(testAccConnectionClientUpdateConnection, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_connection.shared", "options.0.password_policy", "fair"),
+					resource.TestCheckResourceAttr("auth0_connection.shared", "enabled_clients.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "auth0_connection_client.first",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccConnectionClient = `
+
+resource auth0_connection shared {
+	name = "Acceptance-Test-Connection-Client-{{.random}}"
+	strategy = "auth0"
+}
+
+resource auth0_client first {
+	name = "Acceptance Test - Connection Client First - {{.random}}"
+}
+
+resource auth0_client second {
+	name = "Acceptance Test - Connection Client Second - {{.random}}"
+}
+
+resource auth0_connection_client first {
+	connection_id = auth0_connection.shared.id
+	client_id = auth0_client.first.id
+}
+
+resource auth0_connection_client second {
+	connection_id = auth0_connection.shared.id
+	client_id = auth0_client.second.id
+}
+`
+
+const testAccConnectionClientUpdateConnection = `
+
+resource auth0_connection shared {
+	name = "Acceptance-Test-Connection-Client-{{.random}}"
+	strategy = "auth0"
+	options {
+		password_policy = "fair"
+	}
+}
+
+resource auth0_client first {
+	name = "Acceptance Test - Connection Client First - {{.random}}"
+}
+
+resource auth0_client second {
+	name = "Acceptance Test - Connection Client Second - {{.random}}"
+}
+
+resource auth0_connection_client first {
+	connection_id = auth0_connection.shared.id
+	client_id = auth0_client.first.id
+}
+
+resource auth0_connection_client second {
+	connection_id = auth0_connection.shared.id
+	client_id = auth0_client.second.id
+}
+`
+
+func TestToggleConnectionClient(t *testing.T) {
+	for _, test := range []struct {
+		clients  []interface{}
+		clientID string
+		enable   bool
+		expected []interface{}
+		changed  bool
+	}{
+		{[]interface{}{"a", "b"}, "c", true, []interface{}{"a", "b", "c"}, true},
+		{[]interface{}{"a", "b"}, "b", true, []interface{}{"a", "b"}, false},
+		{[]interface{}{"a", "b"}, "a", false, []interface{}{"b"}, true},
+		{[]interface{}{"a", "b"}, "c", false, []interface{}{"a", "b"}, false},
+		{[]interface{}{"a"}, "a", false, []interface{}{}, true},
+		{nil, "a", true, []interface{}{"a"}, true},
+	} {
+		clients, changed := toggleConnectionClient(test.clients, test.clientID, test.enable)
+		if !reflect.DeepEqual(clients, test.expected) {
+			t.Errorf("expected clients %v, got %v", test.expected, clients)
+		}
+		if changed != test.changed {
+			t.Errorf("expected changed to be %t, got %t", test.changed, changed)
+		}
+	}
+}
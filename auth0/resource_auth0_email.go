@@ -1,14 +1,48 @@
 package auth0
 
 import (
+	"fmt"
 	"net/http"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
 	"gopkg.in/auth0.v5"
 	"gopkg.in/auth0.v5/management"
 )
 
+// email is used instead of management.Email, so that the connection string of
+// Azure Communication Services can be sent along with the other credentials.
+type email struct {
+	Name               *string           `json:"name,omitempty"`
+	Enabled            *bool             `json:"enabled,omitempty"`
+	DefaultFromAddress *string           `json:"default_from_address,omitempty"`
+	Credentials        *emailCredentials `json:"credentials,omitempty"`
+}
+
+type emailCredentials struct {
+	management.EmailCredentials
+	AzureCSConnectionString *string `json:"connectionString,omitempty"`
+}
+
+// emailProviders lists the credentials each email provider requires and
+// accepts. Any other credential is rejected at plan time.
+var emailProviders = map[string]struct {
+	required []string
+	optional []string
+	regions  []string
+}{
+	"mandrill":  {required: []string{"api_key"}},
+	"sendgrid":  {required: []string{"api_key"}, optional: []string{"api_user"}},
+	"sparkpost": {required: []string{"api_key"}, optional: []string{"region"}, regions: []string{"eu"}},
+	"mailgun":   {required: []string{"api_key", "domain"}, optional: []string{"region"}, regions: []string{"eu"}},
+	"ses":       {required: []string{"access_key_id", "secret_access_key", "region"}},
+	"smtp":      {required: []string{"smtp_host", "smtp_port", "smtp_user", "smtp_pass"}},
+	"azure_cs":  {required: []string{"azure_cs_connection_string"}},
+}
+
 func newEmail() *schema.Resource {
 	return &schema.Resource{
 
@@ -20,10 +54,21 @@ func newEmail() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: validateEmail,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"azure_cs",
+					"mailgun",
+					"mandrill",
+					"sendgrid",
+					"ses",
+					"smtp",
+					"sparkpost",
+				}, false),
 			},
 			"enabled": {
 				Type:     schema.TypeBool,
@@ -87,6 +132,12 @@ func newEmail() *schema.Resource {
 							Sensitive: true,
 							ForceNew:  true,
 						},
+						"azure_cs_connection_string": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+							ForceNew:  true,
+						},
 					},
 				},
 			},
@@ -97,7 +148,7 @@ func newEmail() *schema.Resource {
 func createEmail(d *schema.ResourceData, m interface{}) error {
 	e := buildEmail(d)
 	api := m.(*management.Management)
-	if err := api.Email.Create(e); err != nil {
+	if err := api.Request("POST", api.URI("emails", "provider"), e); err != nil {
 		return err
 	}
 	d.SetId(auth0.StringValue(e.Name))
@@ -134,6 +185,7 @@ func readEmail(d *schema.ResourceData, m interface{}) error {
 		credentialsMap["smtp_port"] = credentials.SMTPPort
 		credentialsMap["smtp_user"] = credentials.SMTPUser
 		credentialsMap["smtp_pass"] = d.Get("credentials.0.smtp_pass")
+		credentialsMap["azure_cs_connection_string"] = d.Get("credentials.0.azure_cs_connection_string")
 		d.Set("credentials", []map[string]interface{}{credentialsMap})
 	}
 
@@ -143,7 +195,7 @@ func readEmail(d *schema.ResourceData, m interface{}) error {
 func updateEmail(d *schema.ResourceData, m interface{}) error {
 	e := buildEmail(d)
 	api := m.(*management.Management)
-	err := api.Email.Update(e)
+	err := api.Request("PATCH", api.URI("emails", "provider"), e)
 	if err != nil {
 		return err
 	}
@@ -164,43 +216,101 @@ func deleteEmail(d *schema.ResourceData, m interface{}) error {
 	return err
 }
 
-func buildEmail(d *schema.ResourceData) *management.Email {
-	e := &management.Email{
+func buildEmail(d *schema.ResourceData) *email {
+	e := &email{
 		Name:               String(d, "name"),
 		Enabled:            Bool(d, "enabled"),
 		DefaultFromAddress: String(d, "default_from_address"),
 	}
 
 	List(d, "credentials").Elem(func(d ResourceData) {
-		// e.Credentials = buildEmailCredentials(v.(map[string]interface{}))
-		e.Credentials = &management.EmailCredentials{
-			APIUser:         String(d, "api_user"),
-			APIKey:          String(d, "api_key"),
-			AccessKeyID:     String(d, "access_key_id"),
-			SecretAccessKey: String(d, "secret_access_key"),
-			Region:          String(d, "region"),
-			Domain:          String(d, "domain"),
-			SMTPHost:        String(d, "smtp_host"),
-			SMTPPort:        Int(d, "smtp_port"),
-			SMTPUser:        String(d, "smtp_user"),
-			SMTPPass:        String(d, "smtp_pass"),
+		e.Credentials = &emailCredentials{
+			EmailCredentials: management.EmailCredentials{
+				APIUser:         String(d, "api_user"),
+				APIKey:          String(d, "api_key"),
+				AccessKeyID:     String(d, "access_key_id"),
+				SecretAccessKey: String(d, "secret_access_key"),
+				Region:          String(d, "region"),
+				Domain:          String(d, "domain"),
+				SMTPHost:        String(d, "smtp_host"),
+				SMTPPort:        Int(d, "smtp_port"),
+				SMTPUser:        String(d, "smtp_user"),
+				SMTPPass:        String(d, "smtp_pass"),
+			},
+			AzureCSConnectionString: String(d, "azure_cs_connection_string"),
 		}
 	})
 
 	return e
 }
 
-func buildEmailCredentials(m map[string]interface{}) *management.EmailCredentials {
-	return &management.EmailCredentials{
-		APIUser:         String(MapData(m), "api_user"),
-		APIKey:          String(MapData(m), "api_key"),
-		AccessKeyID:     String(MapData(m), "access_key_id"),
-		SecretAccessKey: String(MapData(m), "secret_access_key"),
-		Region:          String(MapData(m), "region"),
-		Domain:          String(MapData(m), "domain"),
-		SMTPHost:        String(MapData(m), "smtp_host"),
-		SMTPPort:        Int(MapData(m), "smtp_port"),
-		SMTPUser:        String(MapData(m), "smtp_user"),
-		SMTPPass:        String(MapData(m), "smtp_pass"),
+// validateEmail checks at plan time that the credentials match the ones the
+// email provider expects. Credentials that aren't known yet, e.g. because they
+// are read from another resource, are considered set.
+func validateEmail(d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("name") {
+		return nil
+	}
+
+	credentials := make(map[string]interface{})
+	for _, provider := range emailProviders {
+		for _, key := range append(provider.required, provider.optional...) {
+			k := "credentials.0." + key
+			if !d.NewValueKnown(k) {
+				credentials[key] = nil
+			} else if v, ok := d.GetOk(k); ok {
+				credentials[key] = v
+			}
+		}
+	}
+
+	return validateEmailCredentials(d.Get("name").(string), credentials)
+}
+
+// validateEmailCredentials checks the credentials that are set against the
+// ones required and accepted by the named email provider. A nil value stands
+// for a credential that is set, but not known yet.
+func validateEmailCredentials(name string, credentials map[string]interface{}) error {
+	provider, ok := emailProviders[name]
+	if !ok {
+		return nil
+	}
+
+	accepted := make(map[string]bool)
+	var missing []string
+	for _, key := range provider.required {
+		accepted[key] = true
+		if _, ok := credentials[key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("email provider %q requires credentials %s", name, strings.Join(missing, ", "))
+	}
+
+	for _, key := range provider.optional {
+		accepted[key] = true
+	}
+	var unexpected []string
+	for key := range credentials {
+		if !accepted[key] {
+			unexpected = append(unexpected, key)
+		}
+	}
+	if len(unexpected) > 0 {
+		sort.Strings(unexpected)
+		return fmt.Errorf("email provider %q does not accept credentials %s", name, strings.Join(unexpected, ", "))
+	}
+
+	region, ok := credentials["region"].(string)
+	if !ok || len(provider.regions) == 0 {
+		return nil
+	}
+	for _, r := range provider.regions {
+		if r == region {
+			return nil
+		}
 	}
+	return fmt.Errorf("email provider %q does not support region %q, expected %s or no region",
+		name, region, strings.Join(provider.regions, ", "))
 }
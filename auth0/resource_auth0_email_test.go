@@ -1,6 +1,7 @@
 package auth0
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -92,6 +93,63 @@ func TestAccEmail(t *testing.T) {
 					resource.TestCheckResourceAttr("auth0_email.my_email_provider", "credentials.0.region", "eu"),
 				),
 			},
+			{
+				Config: `
+				resource "auth0_email" "my_email_provider" {
+					name = "sparkpost"
+					enabled = true
+					default_from_address = "accounts@example.com"
+					credentials {
+						api_key = "SPARKPOSTXXXXXXXXXXXXXX"
+						smtp_host = "smtp.example.com"
+					}
+				}
+				`,
+				ExpectError: regexp.MustCompile(`email provider "sparkpost" does not accept credentials smtp_host`),
+			},
+			{
+				Config: `
+				resource "auth0_email" "my_email_provider" {
+					name = "azure_cs"
+					enabled = true
+					default_from_address = "accounts@example.com"
+					credentials {
+						azure_cs_connection_string = "endpoint=https://example.communication.azure.com/;accesskey=XXXXXXXX"
+					}
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_email.my_email_provider", "name", "azure_cs"),
+					resource.TestCheckResourceAttr("auth0_email.my_email_provider", "credentials.0.azure_cs_connection_string", "endpoint=https://example.communication.azure.com/;accesskey=XXXXXXXX"),
+				),
+			},
 		},
 	})
 }
+
+func TestValidateEmailCredentials(t *testing.T) {
+	for _, test := range []struct {
+		name        string
+		credentials map[string]interface{}
+		err         string
+	}{
+		{"mandrill", map[string]interface{}{"api_key": "key"}, ""},
+		{"mandrill", map[string]interface{}{}, `email provider "mandrill" requires credentials api_key`},
+		{"mailgun", map[string]interface{}{"api_key": "key", "domain": "example.com", "region": "eu"}, ""},
+		{"mailgun", map[string]interface{}{"api_key": "key", "domain": "example.com", "region": "us"}, `email provider "mailgun" does not support region "us", expected eu or no region`},
+		{"mailgun", map[string]interface{}{"api_key": "key", "domain": "example.com", "region": nil}, ""},
+		{"ses", map[string]interface{}{"access_key_id": "id", "secret_access_key": "secret", "region": "us-east-1"}, ""},
+		{"ses", map[string]interface{}{"access_key_id": "id", "region": "us-east-1"}, `email provider "ses" requires credentials secret_access_key`},
+		{"sparkpost", map[string]interface{}{"api_key": "key", "smtp_host": "smtp.example.com", "domain": "example.com"}, `email provider "sparkpost" does not accept credentials domain, smtp_host`},
+		{"smtp", map[string]interface{}{"smtp_host": "smtp.example.com", "smtp_port": 587, "smtp_user": "user", "smtp_pass": nil}, ""},
+		{"azure_cs", map[string]interface{}{"azure_cs_connection_string": "endpoint=..."}, ""},
+	} {
+		err := validateEmailCredentials(test.name, test.credentials)
+		if test.err == "" && err != nil {
+			t.Errorf("unexpected error for %s %v: %v", test.name, test.credentials, err)
+		}
+		if test.err != "" && (err == nil || err.Error() != test.err) {
+			t.Errorf("expected error %q for %s %v, got %v", test.err, test.name, test.credentials, err)
+		}
+	}
+}
@@ -0,0 +1,339 @@
+package auth0
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/management"
+)
+
+// guardianID is the ID of the auth0_guardian resource. As the MFA settings
+// are a singleton per tenant, the ID carries no information.
+const guardianID = "guardian"
+
+// guardianFactors maps the schema keys of the auth0_guardian resource to the
+// names of the factors in the Management API.
+var guardianFactors = map[string]string{
+	"otp":               "otp",
+	"email":             "email",
+	"push":              "push-notification",
+	"webauthn_roaming":  "webauthn-roaming",
+	"webauthn_platform": "webauthn-platform",
+	"recovery_code":     "recovery-code",
+}
+
+func newGuardian() *schema.Resource {
+	return &schema.Resource{
+
+		Create: createGuardian,
+		Read:   readGuardian,
+		Update: updateGuardian,
+		Delete: deleteGuardian,
+		Importer: &schema.ResourceImporter{
+			State: importGuardian,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"never", "all-applications", "confidence-score",
+				}, false),
+				Description: "Policy to use. Options include `never`, `all-applications` and `confidence-score`",
+			},
+			"otp": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates whether or not One-time Password MFA is enabled",
+			},
+			"email": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates whether or not email MFA is enabled",
+			},
+			"push": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates whether or not push notification MFA (via Auth0 Guardian) is enabled",
+			},
+			"webauthn_roaming": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates whether or not WebAuthn with FIDO security keys is enabled",
+			},
+			"webauthn_platform": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates whether or not WebAuthn with FIDO device biometrics is enabled",
+			},
+			"recovery_code": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates whether or not recovery codes are enabled",
+			},
+			"sms": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"provider": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"auth0", "twilio", "phone-message-hook",
+							}, false),
+							Description: "Provider used to send SMS messages. Options include `auth0`, `twilio` and `phone-message-hook`",
+						},
+						"enrollment_message": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "Message sent to the user when they are invited to enroll with a phone number",
+						},
+						"verification_message": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "Message sent to the user when they are prompted to verify their account",
+						},
+						"twilio": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"sid": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Twilio account SID",
+									},
+									"auth_token": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Sensitive:   true,
+										Description: "Twilio authentication token",
+									},
+									"from": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "Phone number to send SMS messages from",
+									},
+									"messaging_service_sid": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "Twilio Copilot messaging service SID",
+									},
+								},
+							},
+						},
+					},
+				},
+				Description: "Configuration settings for SMS MFA. SMS MFA is enabled if this block is present",
+			},
+		},
+	}
+}
+
+func createGuardian(d *schema.ResourceData, m interface{}) error {
+	d.SetId(guardianID)
+	return updateGuardian(d, m)
+}
+
+func readGuardian(d *schema.ResourceData, m interface{}) error {
+	api := m.(*management.Management)
+
+	var policies management.MultiFactorPolicies
+	err := api.Request("GET", api.URI("guardian", "policies"), &policies)
+	if err != nil {
+		if mErr, ok := err.(management.Error); ok {
+			if mErr.Status() == http.StatusNotFound {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+	d.Set("policy", flattenGuardianPolicy(policies))
+
+	factors, err := api.Guardian.MultiFactor.List()
+	if err != nil {
+		return err
+	}
+
+	enabled := make(map[string]bool)
+	for _, factor := range factors {
+		enabled[factor.GetName()] = factor.GetEnabled()
+	}
+	for key, name := range guardianFactors {
+		d.Set(key, enabled[name])
+	}
+
+	if !enabled["sms"] {
+		d.Set("sms", nil)
+		return nil
+	}
+
+	provider, err := api.Guardian.MultiFactor.Phone.Provider()
+	if err != nil {
+		return err
+	}
+	template, err := api.Guardian.MultiFactor.SMS.Template()
+	if err != nil {
+		return err
+	}
+
+	var twilio *management.MultiFactorProviderTwilio
+	if provider.GetProvider() == "twilio" {
+		twilio, err = api.Guardian.MultiFactor.SMS.Twilio()
+		if err != nil {
+			return err
+		}
+	}
+
+	d.Set("sms", flattenGuardianSMS(provider, template, twilio))
+
+	return nil
+}
+
+func updateGuardian(d *schema.ResourceData, m interface{}) error {
+	api := m.(*management.Management)
+
+	if d.IsNewResource() || d.HasChange("policy") {
+		policies := expandGuardianPolicy(d.Get("policy").(string))
+		if err := api.Guardian.MultiFactor.UpdatePolicy(&policies); err != nil {
+			return err
+		}
+	}
+
+	for key, name := range guardianFactors {
+		if enabled := Bool(d, key, IsNewResource(), HasChange()); enabled != nil {
+			if err := enableGuardianFactor(api, name, *enabled); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := updateGuardianSMS(d, api); err != nil {
+		return err
+	}
+
+	return readGuardian(d, m)
+}
+
+// importGuardian only accepts the fixed ID of the resource, so that no other
+// ID ends up in state.
+func importGuardian(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if d.Id() != guardianID {
+		return nil, fmt.Errorf("invalid guardian ID %q, expected %q", d.Id(), guardianID)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func deleteGuardian(d *schema.ResourceData, m interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+// enableGuardianFactor enables or disables a factor by its name. The SDK only
+// implements this for some of the factors, so we call the API directly for
+// all of them.
+func enableGuardianFactor(api *management.Management, name string, enabled bool) error {
+	return api.Request("PUT", api.URI("guardian", "factors", name), &management.MultiFactor{
+		Enabled: auth0.Bool(enabled),
+	})
+}
+
+func updateGuardianSMS(d *schema.ResourceData, api *management.Management) error {
+	if !d.IsNewResource() && !d.HasChange("sms") {
+		return nil
+	}
+
+	var provider *management.MultiFactorProvider
+	var template *management.MultiFactorSMSTemplate
+	var twilio *management.MultiFactorProviderTwilio
+
+	List(d, "sms").Elem(func(d ResourceData) {
+		provider = &management.MultiFactorProvider{
+			Provider: String(d, "provider"),
+		}
+		template = &management.MultiFactorSMSTemplate{
+			EnrollmentMessage:   String(d, "enrollment_message"),
+			VerificationMessage: String(d, "verification_message"),
+		}
+		List(d, "twilio").Elem(func(d ResourceData) {
+			twilio = &management.MultiFactorProviderTwilio{
+				SID:                 String(d, "sid"),
+				AuthToken:           String(d, "auth_token"),
+				From:                String(d, "from"),
+				MessagingServiceSid: String(d, "messaging_service_sid"),
+			}
+		})
+	})
+
+	if provider == nil {
+		return enableGuardianFactor(api, "sms", false)
+	}
+
+	if err := api.Guardian.MultiFactor.Phone.UpdateProvider(provider); err != nil {
+		return err
+	}
+
+	if template.EnrollmentMessage != nil || template.VerificationMessage != nil {
+		if err := api.Guardian.MultiFactor.SMS.UpdateTemplate(template); err != nil {
+			return err
+		}
+	}
+
+	if twilio != nil {
+		if err := api.Guardian.MultiFactor.SMS.UpdateTwilio(twilio); err != nil {
+			return err
+		}
+	}
+
+	return enableGuardianFactor(api, "sms", true)
+}
+
+func flattenGuardianPolicy(policies management.MultiFactorPolicies) string {
+	if len(policies) == 0 {
+		return "never"
+	}
+	return policies[0]
+}
+
+func expandGuardianPolicy(policy string) management.MultiFactorPolicies {
+	if policy == "never" {
+		return management.MultiFactorPolicies{}
+	}
+	return management.MultiFactorPolicies{policy}
+}
+
+func flattenGuardianSMS(provider *management.MultiFactorProvider, template *management.MultiFactorSMSTemplate, twilio *management.MultiFactorProviderTwilio) []interface{} {
+	m := map[string]interface{}{
+		"provider":             provider.GetProvider(),
+		"enrollment_message":   template.GetEnrollmentMessage(),
+		"verification_message": template.GetVerificationMessage(),
+	}
+	if twilio != nil {
+		m["twilio"] = []interface{}{
+			map[string]interface{}{
+				"sid":                   twilio.GetSID(),
+				"auth_token":            twilio.GetAuthToken(),
+				"from":                  twilio.GetFrom(),
+				"messaging_service_sid": twilio.GetMessagingServiceSid(),
+			},
+		}
+	}
+	return []interface{}{m}
+}
@@ -0,0 +1,126 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccGuardian(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardianCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_guardian.default", "id", "guardian"),
+					resource.TestCheckResourceAttr("auth0_guardian.default", "policy", "all-applications"),
+					resource.TestCheckResourceAttr("auth0_guardian.default", "otp", "true"),
+					resource.TestCheckResourceAttr("auth0_guardian.default", "email", "false"),
+					resource.TestCheckResourceAttr("auth0_guardian.default", "sms.#", "1"),
+					resource.TestCheckResourceAttr("auth0_guardian.default", "sms.0.provider", "auth0"),
+					resource.TestCheckResourceAttr("auth0_guardian.default", "sms.0.enrollment_message", "{{code}} is your verification code for {{tenant.friendly_name}}. Please enter this code to verify your enrollment."),
+				),
+			},
+			{
+				Config: testAccGuardianUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_guardian.default", "policy", "confidence-score"),
+					resource.TestCheckResourceAttr("auth0_guardian.default", "otp", "false"),
+					resource.TestCheckResourceAttr("auth0_guardian.default", "email", "true"),
+					resource.TestCheckResourceAttr("auth0_guardian.default", "recovery_code", "true"),
+					resource.TestCheckResourceAttr("auth0_guardian.default", "sms.0.provider", "twilio"),
+					resource.TestCheckResourceAttr("auth0_guardian.default", "sms.0.twilio.0.sid", "ACxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"),
+					resource.TestCheckResourceAttr("auth0_guardian.default", "sms.0.twilio.0.from", "+15555555555"),
+				),
+			},
+			{
+				Config: testAccGuardianDisable,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_guardian.default", "policy", "never"),
+					resource.TestCheckResourceAttr("auth0_guardian.default", "email", "false"),
+					resource.TestCheckResourceAttr("auth0_guardian.default", "recovery_code", "false"),
+					resource.TestCheckResourceAttr("auth0_guardian.default", "sms.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "auth0_guardian.default",
+				ImportState:       true,
+				ImportStateId:     "guardian",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccGuardianCreate = `
+
+resource "auth0_guardian" "default" {
+  policy = "all-applications"
+  otp = true
+  sms {
+    provider = "auth0"
+    enrollment_message = "{{code}} is your verification code for {{tenant.friendly_name}}. Please enter this code to verify your enrollment."
+    verification_message = "{{code}} is your verification code for {{tenant.friendly_name}}"
+  }
+}
+`
+
+const testAccGuardianUpdate = `
+
+resource "auth0_guardian" "default" {
+  policy = "confidence-score"
+  email = true
+  recovery_code = true
+  sms {
+    provider = "twilio"
+    twilio {
+      sid = "ACxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+      auth_token = "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+      from = "+15555555555"
+    }
+  }
+}
+`
+
+const testAccGuardianDisable = `
+
+resource "auth0_guardian" "default" {
+  policy = "never"
+}
+`
+
+func TestGuardianPolicy(t *testing.T) {
+	for _, policy := range []string{"never", "all-applications", "confidence-score"} {
+		if p := flattenGuardianPolicy(expandGuardianPolicy(policy)); p != policy {
+			t.Errorf("Expected policy %q, got %q", policy, p)
+		}
+	}
+	if p := expandGuardianPolicy("never"); p == nil || len(p) != 0 {
+		t.Errorf("Expected policy never to be sent as an empty list, got %#v", p)
+	}
+}
+
+func TestImportGuardian(t *testing.T) {
+	for _, test := range []struct {
+		id  string
+		err bool
+	}{
+		{"guardian", false},
+		{"foo", true},
+		{"", true},
+	} {
+		d := newGuardian().Data(&terraform.InstanceState{ID: test.id})
+		_, err := importGuardian(d, nil)
+		if test.err && err == nil {
+			t.Errorf("expected an error importing %q", test.id)
+		}
+		if !test.err && err != nil {
+			t.Errorf("unexpected error importing %q: %v", test.id, err)
+		}
+	}
+}
@@ -0,0 +1,328 @@
+package auth0
+
+import (
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/management"
+)
+
+// organization holds the details of an organization. Its enabled connections
+// are managed through their own endpoints.
+type organization struct {
+	ID          *string                 `json:"id,omitempty"`
+	Name        *string                 `json:"name,omitempty"`
+	DisplayName *string                 `json:"display_name,omitempty"`
+	Branding    *organizationBranding   `json:"branding,omitempty"`
+	Metadata    *map[string]interface{} `json:"metadata,omitempty"`
+}
+
+type organizationBranding struct {
+	LogoURL *string            `json:"logo_url,omitempty"`
+	Colors  *map[string]string `json:"colors,omitempty"`
+}
+
+type organizationConnection struct {
+	ConnectionID            *string `json:"connection_id,omitempty"`
+	AssignMembershipOnLogin *bool   `json:"assign_membership_on_login,omitempty"`
+}
+
+// GetConnectionID returns the ConnectionID field if it's non-nil, zero value
+// otherwise.
+func (c *organizationConnection) GetConnectionID() string {
+	return auth0.StringValue(c.ConnectionID)
+}
+
+type organizationConnectionList struct {
+	management.List
+	EnabledConnections []*organizationConnection `json:"enabled_connections"`
+}
+
+func newOrganization() *schema.Resource {
+	return &schema.Resource{
+
+		Create: createOrganization,
+		Read:   readOrganization,
+		Update: updateOrganization,
+		Delete: deleteOrganization,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 50),
+					validation.StringMatch(
+						regexp.MustCompile(`^[a-z0-9_-]+$`),
+						"expected name to contain only lowercase letters, numbers, hyphens and underscores",
+					),
+				),
+				Description: "The name of the organization. Used by end users to identify the organization",
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Friendly name of the organization",
+			},
+			"branding": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"logo_url": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithScheme([]string{"https"}),
+							Description:  "URL of the logo of the organization",
+						},
+						"colors": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Description: "Color scheme used to customize the login pages. " +
+								"Supported keys are `primary` and `page_background`",
+						},
+					},
+				},
+				Description: "Defines how to style the login pages",
+			},
+			"metadata": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Metadata associated with the organization",
+			},
+			"connections": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connection_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"assign_membership_on_login": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether users logging in with this connection are automatically granted membership in the organization",
+						},
+					},
+				},
+				Description: "Connections enabled for the organization",
+			},
+		},
+	}
+}
+
+func createOrganization(d *schema.ResourceData, m interface{}) error {
+	o := expandOrganization(d)
+	api := m.(*management.Management)
+	if err := api.Request("POST", api.URI("organizations"), o); err != nil {
+		return err
+	}
+	d.SetId(auth0.StringValue(o.ID))
+
+	d.Partial(true)
+	if err := assignOrganizationConnections(d, m); err != nil {
+		return err
+	}
+	d.Partial(false)
+
+	return readOrganization(d, m)
+}
+
+func readOrganization(d *schema.ResourceData, m interface{}) error {
+	api := m.(*management.Management)
+
+	var o organization
+	err := api.Request("GET", api.URI("organizations", d.Id()), &o)
+	if err != nil {
+		if mErr, ok := err.(management.Error); ok {
+			if mErr.Status() == http.StatusNotFound {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	d.Set("name", o.Name)
+	d.Set("display_name", o.DisplayName)
+	d.Set("branding", flattenOrganizationBranding(o.Branding))
+	if o.Metadata != nil {
+		d.Set("metadata", *o.Metadata)
+	} else {
+		d.Set("metadata", nil)
+	}
+
+	var connections []*organizationConnection
+	var page int
+	for {
+		var l organizationConnectionList
+		err := api.Request("GET", api.URI("organizations", d.Id(), "enabled_connections"), &l,
+			management.Page(page),
+			management.IncludeTotals(true))
+		if err != nil {
+			return err
+		}
+		connections = append(connections, l.EnabledConnections...)
+		if !l.HasNext() {
+			break
+		}
+		page++
+	}
+	d.Set("connections", flattenOrganizationConnections(connections))
+
+	return nil
+}
+
+func updateOrganization(d *schema.ResourceData, m interface{}) error {
+	o := expandOrganization(d)
+	api := m.(*management.Management)
+	err := api.Request("PATCH", api.URI("organizations", d.Id()), o)
+	if err != nil {
+		return err
+	}
+
+	d.Partial(true)
+	if err := assignOrganizationConnections(d, m); err != nil {
+		return err
+	}
+	d.Partial(false)
+
+	return readOrganization(d, m)
+}
+
+func deleteOrganization(d *schema.ResourceData, m interface{}) error {
+	api := m.(*management.Management)
+	err := api.Request("DELETE", api.URI("organizations", d.Id()), nil)
+	if err != nil {
+		if mErr, ok := err.(management.Error); ok {
+			if mErr.Status() == http.StatusNotFound {
+				d.SetId("")
+				return nil
+			}
+		}
+	}
+	return err
+}
+
+func expandOrganization(d *schema.ResourceData) *organization {
+	o := &organization{
+		Name:        String(d, "name"),
+		DisplayName: String(d, "display_name"),
+	}
+
+	List(d, "branding").Elem(func(d ResourceData) {
+		o.Branding = &organizationBranding{
+			LogoURL: String(d, "logo_url"),
+		}
+		if colors := Map(d, "colors"); colors != nil {
+			c := make(map[string]string, len(colors))
+			for k, v := range colors {
+				c[k] = v.(string)
+			}
+			o.Branding.Colors = &c
+		}
+	})
+
+	metadata := Map(d, "metadata")
+	if metadata == nil {
+		metadata = make(map[string]interface{})
+	}
+	o.Metadata = &metadata
+
+	return o
+}
+
+// assignOrganizationConnections enables and disables connections for the
+// organization. A connection that was only changed, e.g. by toggling
+// assign_membership_on_login, shows up in both sets of the diff and is
+// updated in place rather than disabled and enabled again.
+func assignOrganizationConnections(d *schema.ResourceData, m interface{}) error {
+
+	add, rm := Diff(d, "connections")
+
+	rmConnections := make(map[string]bool)
+	for _, rmConnection := range rm {
+		connection := rmConnection.(map[string]interface{})
+		rmConnections[connection["connection_id"].(string)] = true
+	}
+
+	var addConnections, updateConnections []*organizationConnection
+	for _, addConnection := range add {
+		connection := addConnection.(map[string]interface{})
+		c := &organizationConnection{
+			ConnectionID:            auth0.String(connection["connection_id"].(string)),
+			AssignMembershipOnLogin: auth0.Bool(connection["assign_membership_on_login"].(bool)),
+		}
+		if rmConnections[c.GetConnectionID()] {
+			delete(rmConnections, c.GetConnectionID())
+			updateConnections = append(updateConnections, c)
+		} else {
+			addConnections = append(addConnections, c)
+		}
+	}
+
+	api := m.(*management.Management)
+
+	for id := range rmConnections {
+		err := api.Request("DELETE", api.URI("organizations", d.Id(), "enabled_connections", id), nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, c := range updateConnections {
+		uri := api.URI("organizations", d.Id(), "enabled_connections", c.GetConnectionID())
+		err := api.Request("PATCH", uri, &organizationConnection{
+			AssignMembershipOnLogin: c.AssignMembershipOnLogin,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, c := range addConnections {
+		err := api.Request("POST", api.URI("organizations", d.Id(), "enabled_connections"), c)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetPartial("connections")
+	return nil
+}
+
+func flattenOrganizationBranding(b *organizationBranding) []interface{} {
+	if b == nil {
+		return nil
+	}
+	m := map[string]interface{}{
+		"logo_url": auth0.StringValue(b.LogoURL),
+	}
+	if b.Colors != nil {
+		m["colors"] = *b.Colors
+	}
+	return []interface{}{m}
+}
+
+func flattenOrganizationConnections(connections []*organizationConnection) []interface{} {
+	var v []interface{}
+	for _, c := range connections {
+		v = append(v, map[string]interface{}{
+			"connection_id":              c.GetConnectionID(),
+			"assign_membership_on_login": auth0.BoolValue(c.AssignMembershipOnLogin),
+		})
+	}
+	return v
+}
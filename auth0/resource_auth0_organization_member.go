@@ -0,0 +1,240 @@
+package auth0
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/management"
+)
+
+type organizationMembers struct {
+	Members []string `json:"members"`
+}
+
+type organizationMemberRoles struct {
+	Roles []string `json:"roles"`
+}
+
+type organizationList struct {
+	management.List
+	Organizations []*organization `json:"organizations"`
+}
+
+type organizationMemberRoleList struct {
+	management.List
+	Roles []*management.Role `json:"roles"`
+}
+
+func newOrganizationMember() *schema.Resource {
+	return &schema.Resource{
+
+		Create: createOrganizationMember,
+		Read:   readOrganizationMember,
+		Update: updateOrganizationMember,
+		Delete: deleteOrganizationMember,
+		Importer: &schema.ResourceImporter{
+			State: importOrganizationMember,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the organization",
+			},
+			"user_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the user to add as a member of the organization",
+			},
+			"roles": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the roles granted to the member within the organization",
+			},
+		},
+	}
+}
+
+func createOrganizationMember(d *schema.ResourceData, m interface{}) error {
+	orgID := d.Get("org_id").(string)
+	userID := d.Get("user_id").(string)
+
+	api := m.(*management.Management)
+	err := api.Request("POST", api.URI("organizations", orgID, "members"), &organizationMembers{
+		Members: []string{userID},
+	})
+	if err != nil {
+		return err
+	}
+	d.SetId(orgID + ":" + userID)
+
+	d.Partial(true)
+	if err := assignOrganizationMemberRoles(d, m); err != nil {
+		return err
+	}
+	d.Partial(false)
+
+	return readOrganizationMember(d, m)
+}
+
+func readOrganizationMember(d *schema.ResourceData, m interface{}) error {
+	orgID, userID, err := parseOrganizationMemberID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	api := m.(*management.Management)
+
+	var member bool
+	var page int
+	for !member {
+		var l organizationList
+		err := api.Request("GET", api.URI("users", userID, "organizations"), &l,
+			management.Page(page),
+			management.IncludeTotals(true))
+		if err != nil {
+			if mErr, ok := err.(management.Error); ok {
+				if mErr.Status() == http.StatusNotFound {
+					d.SetId("")
+					return nil
+				}
+			}
+			return err
+		}
+		for _, o := range l.Organizations {
+			if auth0.StringValue(o.ID) == orgID {
+				member = true
+			}
+		}
+		if !l.HasNext() {
+			break
+		}
+		page++
+	}
+
+	// The user exists but is no longer a member of the organization.
+	if !member {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("org_id", orgID)
+	d.Set("user_id", userID)
+
+	var roles []string
+	page = 0
+	for {
+		var l organizationMemberRoleList
+		err := api.Request("GET", api.URI("organizations", orgID, "members", userID, "roles"), &l,
+			management.Page(page),
+			management.IncludeTotals(true))
+		if err != nil {
+			return err
+		}
+		for _, role := range l.Roles {
+			roles = append(roles, role.GetID())
+		}
+		if !l.HasNext() {
+			break
+		}
+		page++
+	}
+	d.Set("roles", roles)
+
+	return nil
+}
+
+func updateOrganizationMember(d *schema.ResourceData, m interface{}) error {
+	d.Partial(true)
+	if err := assignOrganizationMemberRoles(d, m); err != nil {
+		return err
+	}
+	d.Partial(false)
+
+	return readOrganizationMember(d, m)
+}
+
+func deleteOrganizationMember(d *schema.ResourceData, m interface{}) error {
+	orgID, userID, err := parseOrganizationMemberID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	api := m.(*management.Management)
+	err = api.Request("DELETE", api.URI("organizations", orgID, "members"), &organizationMembers{
+		Members: []string{userID},
+	})
+	if err != nil {
+		if mErr, ok := err.(management.Error); ok {
+			if mErr.Status() == http.StatusNotFound {
+				d.SetId("")
+				return nil
+			}
+		}
+	}
+	return err
+}
+
+func importOrganizationMember(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	orgID, userID, err := parseOrganizationMemberID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	d.Set("org_id", orgID)
+	d.Set("user_id", userID)
+	return []*schema.ResourceData{d}, nil
+}
+
+func assignOrganizationMemberRoles(d *schema.ResourceData, m interface{}) error {
+
+	add, rm := Diff(d, "roles")
+
+	var addRoles []string
+	for _, addRole := range add {
+		addRoles = append(addRoles, addRole.(string))
+	}
+
+	var rmRoles []string
+	for _, rmRole := range rm {
+		rmRoles = append(rmRoles, rmRole.(string))
+	}
+
+	api := m.(*management.Management)
+	uri := api.URI("organizations", d.Get("org_id").(string), "members", d.Get("user_id").(string), "roles")
+
+	if len(rmRoles) > 0 {
+		err := api.Request("DELETE", uri, &organizationMemberRoles{Roles: rmRoles})
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(addRoles) > 0 {
+		err := api.Request("POST", uri, &organizationMemberRoles{Roles: addRoles})
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetPartial("roles")
+	return nil
+}
+
+// parseOrganizationMemberID splits the ID of an auth0_organization_member
+// resource, formatted as org_id:user_id, into its parts. Organization IDs never
+// contain a colon, so everything after the first one is the user ID.
+func parseOrganizationMemberID(id string) (orgID, userID string, err error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid organization member ID %q, expected format org_id:user_id", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,104 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/alexkappa/terraform-provider-auth0/auth0/internal/random"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccOrganizationMember(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(testAccOrganizationMemberCreate, rand),
+				Check: resource.ComposeTestCheckFunc(
+					random.TestCheckResourceAttr("auth0_organization_member.member", "user_id", "auth0|{{.random}}", rand),
+					resource.TestCheckResourceAttr("auth0_organization_member.member", "roles.#", "1"),
+				),
+			},
+			{
+				Config: random.Template(testAccOrganizationMemberUpdate, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_organization_member.member", "roles.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "auth0_organization_member.member",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccOrganizationMemberAux = `
+
+resource auth0_organization acme {
+	name = "test-{{.random}}"
+	display_name = "Acme Inc. {{.random}}"
+}
+
+resource auth0_user user {
+	connection_name = "Username-Password-Authentication"
+	user_id = "{{.random}}"
+	email = "{{.random}}@acceptance.test.com"
+	password = "passpass$12$12"
+}
+
+resource auth0_role reader {
+	name = "Reader - Acceptance Test - {{.random}}"
+}
+
+resource auth0_role writer {
+	name = "Writer - Acceptance Test - {{.random}}"
+}
+`
+
+const testAccOrganizationMemberCreate = testAccOrganizationMemberAux + `
+
+resource auth0_organization_member member {
+	org_id = auth0_organization.acme.id
+	user_id = auth0_user.user.id
+	roles = [ auth0_role.reader.id ]
+}
+`
+
+const testAccOrganizationMemberUpdate = testAccOrganizationMemberAux + `
+
+resource auth0_organization_member member {
+	org_id = auth0_organization.acme.id
+	user_id = auth0_user.user.id
+	roles = [ auth0_role.reader.id, auth0_role.writer.id ]
+}
+`
+
+func TestParseOrganizationMemberID(t *testing.T) {
+	for _, test := range []struct {
+		id     string
+		orgID  string
+		userID string
+		err    bool
+	}{
+		{"org_123:auth0|456", "org_123", "auth0|456", false},
+		{"org_123:oauth2|custom:456", "org_123", "oauth2|custom:456", false},
+		{"org_123", "", "", true},
+		{":auth0|456", "", "", true},
+		{"org_123:", "", "", true},
+	} {
+		orgID, userID, err := parseOrganizationMemberID(test.id)
+		if test.err != (err != nil) {
+			t.Errorf("unexpected error for %q: %v", test.id, err)
+		}
+		if orgID != test.orgID || userID != test.userID {
+			t.Errorf("expected %q to be parsed to (%q, %q), got (%q, %q)", test.id, test.orgID, test.userID, orgID, userID)
+		}
+	}
+}
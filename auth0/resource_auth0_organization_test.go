@@ -0,0 +1,100 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/alexkappa/terraform-provider-auth0/auth0/internal/random"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccOrganization(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(testAccOrganizationCreate, rand),
+				Check: resource.ComposeTestCheckFunc(
+					random.TestCheckResourceAttr("auth0_organization.acme", "name", "test-{{.random}}", rand),
+					random.TestCheckResourceAttr("auth0_organization.acme", "display_name", "Acme Inc. {{.random}}", rand),
+					resource.TestCheckResourceAttr("auth0_organization.acme", "branding.0.logo_url", "https://acme.com/logo.svg"),
+					resource.TestCheckResourceAttr("auth0_organization.acme", "branding.0.colors.primary", "#e3e2f0"),
+					resource.TestCheckResourceAttr("auth0_organization.acme", "metadata.tier", "gold"),
+					resource.TestCheckResourceAttr("auth0_organization.acme", "connections.#", "1"),
+				),
+			},
+			{
+				Config: random.Template(testAccOrganizationUpdate, rand),
+				Check: resource.ComposeTestCheckFunc(
+					random.TestCheckResourceAttr("auth0_organization.acme", "display_name", "Acme Corp. {{.random}}", rand),
+					resource.TestCheckResourceAttr("auth0_organization.acme", "branding.0.colors.page_background", "#e3e2ff"),
+					resource.TestCheckResourceAttr("auth0_organization.acme", "metadata.tier", "platinum"),
+					resource.TestCheckResourceAttr("auth0_organization.acme", "connections.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+const testAccOrganizationAux = `
+
+resource auth0_connection acme {
+	name = "Acme-Connection-{{.random}}"
+	strategy = "auth0"
+}
+
+resource auth0_connection acme_partners {
+	name = "Acme-Partners-Connection-{{.random}}"
+	strategy = "auth0"
+}
+`
+
+const testAccOrganizationCreate = testAccOrganizationAux + `
+
+resource auth0_organization acme {
+	name = "test-{{.random}}"
+	display_name = "Acme Inc. {{.random}}"
+	branding {
+		logo_url = "https://acme.com/logo.svg"
+		colors = {
+			primary = "#e3e2f0"
+		}
+	}
+	metadata = {
+		tier = "gold"
+	}
+	connections {
+		connection_id = auth0_connection.acme.id
+	}
+}
+`
+
+const testAccOrganizationUpdate = testAccOrganizationAux + `
+
+resource auth0_organization acme {
+	name = "test-{{.random}}"
+	display_name = "Acme Corp. {{.random}}"
+	branding {
+		logo_url = "https://acme.com/logo.svg"
+		colors = {
+			primary = "#e3e2f0"
+			page_background = "#e3e2ff"
+		}
+	}
+	metadata = {
+		tier = "platinum"
+	}
+	connections {
+		connection_id = auth0_connection.acme.id
+		assign_membership_on_login = true
+	}
+	connections {
+		connection_id = auth0_connection.acme_partners.id
+	}
+}
+`
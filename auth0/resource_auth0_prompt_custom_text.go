@@ -0,0 +1,163 @@
+package auth0
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"gopkg.in/auth0.v5/management"
+)
+
+func newPromptCustomText() *schema.Resource {
+	return &schema.Resource{
+
+		Create: createPromptCustomText,
+		Read:   readPromptCustomText,
+		Update: updatePromptCustomText,
+		Delete: deletePromptCustomText,
+		Importer: &schema.ResourceImporter{
+			State: importPromptCustomText,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"prompt": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"login", "login-id", "login-password", "login-email-verification",
+					"signup", "signup-id", "signup-password",
+					"reset-password",
+					"consent",
+					"mfa-push", "mfa-otp", "mfa-voice", "mfa-phone", "mfa-webauthn",
+					"mfa-sms", "mfa-email", "mfa-recovery-code", "mfa",
+					"status", "device-flow", "email-verification", "email-otp-challenge",
+					"organizations", "invitation", "common",
+				}, false),
+				Description: "The prompt the custom text is for, e.g. `login` or `mfa-otp`",
+			},
+			"language": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"ar", "bg", "bs", "cs", "da", "de", "el", "en", "es", "et",
+					"fi", "fr", "fr-CA", "fr-FR", "he", "hi", "hr", "hu", "id",
+					"is", "it", "ja", "ko", "lt", "lv", "nb", "nl", "pl", "pt",
+					"pt-BR", "pt-PT", "ro", "ru", "sk", "sl", "sr", "sv", "th",
+					"tr", "uk", "vi", "zh-CN", "zh-TW",
+				}, false),
+				Description: "Language of the custom text",
+			},
+			"body": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+				Description:      "JSON object of the custom text, keyed by screen and then by text key",
+			},
+		},
+	}
+}
+
+func createPromptCustomText(d *schema.ResourceData, m interface{}) error {
+	d.SetId(d.Get("prompt").(string) + ":" + d.Get("language").(string))
+	return updatePromptCustomText(d, m)
+}
+
+func readPromptCustomText(d *schema.ResourceData, m interface{}) error {
+	prompt, language, err := parsePromptCustomTextID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	api := m.(*management.Management)
+	var text map[string]interface{}
+	err = api.Request("GET", api.URI("prompts", prompt, "custom-text", language), &text)
+	if err != nil {
+		if mErr, ok := err.(management.Error); ok {
+			if mErr.Status() == http.StatusNotFound {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	body, err := structure.FlattenJsonToString(text)
+	if err != nil {
+		return err
+	}
+
+	d.Set("prompt", prompt)
+	d.Set("language", language)
+	d.Set("body", body)
+	return nil
+}
+
+func updatePromptCustomText(d *schema.ResourceData, m interface{}) error {
+	prompt, language, err := parsePromptCustomTextID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	text, err := JSON(d, "body")
+	if err != nil {
+		return err
+	}
+	if text == nil {
+		text = make(map[string]interface{})
+	}
+
+	api := m.(*management.Management)
+	err = api.Request("PUT", api.URI("prompts", prompt, "custom-text", language), &text)
+	if err != nil {
+		return err
+	}
+	return readPromptCustomText(d, m)
+}
+
+func deletePromptCustomText(d *schema.ResourceData, m interface{}) error {
+	prompt, language, err := parsePromptCustomTextID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Setting the custom text to an empty object reverts the prompt to the
+	// default text for the language.
+	api := m.(*management.Management)
+	err = api.Request("PUT", api.URI("prompts", prompt, "custom-text", language), &map[string]interface{}{})
+	if err != nil {
+		if mErr, ok := err.(management.Error); ok {
+			if mErr.Status() == http.StatusNotFound {
+				d.SetId("")
+				return nil
+			}
+		}
+	}
+	return err
+}
+
+func importPromptCustomText(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	prompt, language, err := parsePromptCustomTextID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	d.Set("prompt", prompt)
+	d.Set("language", language)
+	return []*schema.ResourceData{d}, nil
+}
+
+// parsePromptCustomTextID splits the ID of an auth0_prompt_custom_text
+// resource, formatted as prompt:language, into its parts.
+func parsePromptCustomTextID(id string) (prompt, language string, err error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid prompt custom text ID %q, expected format prompt:language", id)
+	}
+	return parts[0], parts[1], nil
+}
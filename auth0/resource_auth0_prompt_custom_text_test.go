@@ -0,0 +1,91 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccPromptCustomText(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPromptCustomTextCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_prompt_custom_text.login_en", "id", "login:en"),
+					resource.TestCheckResourceAttr("auth0_prompt_custom_text.login_en", "prompt", "login"),
+					resource.TestCheckResourceAttr("auth0_prompt_custom_text.login_en", "language", "en"),
+					resource.TestCheckResourceAttr("auth0_prompt_custom_text.login_en", "body", `{"login":{"title":"Welcome"}}`),
+				),
+			},
+			{
+				Config: testAccPromptCustomTextUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_prompt_custom_text.login_en", "body", `{"login":{"description":"Log in to continue","title":"Welcome back"}}`),
+				),
+			},
+			{
+				ResourceName:      "auth0_prompt_custom_text.login_en",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccPromptCustomTextCreate = `
+
+resource "auth0_prompt_custom_text" "login_en" {
+  prompt = "login"
+  language = "en"
+  body = jsonencode({
+    login = {
+      title = "Welcome"
+    }
+  })
+}
+`
+
+const testAccPromptCustomTextUpdate = `
+
+resource "auth0_prompt_custom_text" "login_en" {
+  prompt = "login"
+  language = "en"
+  body = <<EOF
+{
+  "login": {
+    "title": "Welcome back",
+    "description": "Log in to continue"
+  }
+}
+EOF
+}
+`
+
+func TestParsePromptCustomTextID(t *testing.T) {
+	for _, test := range []struct {
+		id       string
+		prompt   string
+		language string
+		err      bool
+	}{
+		{"login:en", "login", "en", false},
+		{"mfa-otp:pt-BR", "mfa-otp", "pt-BR", false},
+		{"login", "", "", true},
+		{"login:", "", "", true},
+		{"login:en:fr", "", "", true},
+	} {
+		prompt, language, err := parsePromptCustomTextID(test.id)
+		if test.err != (err != nil) {
+			t.Errorf("unexpected error for %q: %v", test.id, err)
+		}
+		if prompt != test.prompt || language != test.language {
+			t.Errorf("expected %q to be parsed to (%q, %q), got (%q, %q)", test.id, test.prompt, test.language, prompt, language)
+		}
+	}
+}
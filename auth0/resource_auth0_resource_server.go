@@ -36,6 +36,7 @@ func newResourceServer() *schema.Resource {
 			"scopes": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"value": {
@@ -205,7 +206,9 @@ func expandResourceServer(d *schema.ResourceData) *management.ResourceServer {
 		SkipConsentForVerifiableFirstPartyClients: Bool(d, "skip_consent_for_verifiable_first_party_clients"),
 	}
 
-	Set(d, "scopes").Elem(func(d ResourceData) {
+	// Scopes are only sent when they change, so that scopes managed with
+	// auth0_resource_server_scope aren't overwritten by unrelated updates.
+	Set(d, "scopes", IsNewResource(), HasChange()).Elem(func(d ResourceData) {
 		s.Scopes = append(s.Scopes, &management.ResourceServerScope{
 			Value:       String(d, "value"),
 			Description: String(d, "description"),
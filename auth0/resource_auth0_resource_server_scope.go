@@ -0,0 +1,230 @@
+package auth0
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/mutexkv"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/management"
+)
+
+// resourceServerScopeMutex serializes changes to the scopes of a resource
+// server, as each change is a read-modify-write of the whole set.
+var resourceServerScopeMutex = mutexkv.NewMutexKV()
+
+// resourceServerScopes is used to update only the scopes of a resource server.
+// Unlike management.ResourceServer it also sends an empty list, which is
+// needed to remove the last scope.
+type resourceServerScopes struct {
+	Scopes []*management.ResourceServerScope `json:"scopes"`
+}
+
+func newResourceServerScope() *schema.Resource {
+	return &schema.Resource{
+
+		Create: createResourceServerScope,
+		Read:   readResourceServerScope,
+		Update: updateResourceServerScope,
+		Delete: deleteResourceServerScope,
+		Importer: &schema.ResourceImporter{
+			State: importResourceServerScope,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_server_identifier": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identifier of the resource server the scope belongs to",
+			},
+			"resource_server_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the resource server the scope belongs to",
+			},
+			"scope": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the scope (permission), e.g. `read:messages`",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the scope",
+			},
+		},
+	}
+}
+
+func createResourceServerScope(d *schema.ResourceData, m interface{}) error {
+	identifier := d.Get("resource_server_identifier").(string)
+	scope := d.Get("scope").(string)
+
+	api := m.(*management.Management)
+	id, err := resourceServerScopeServerID(d, api)
+	if err != nil {
+		return err
+	}
+	if id == "" {
+		return fmt.Errorf("no resource server found with identifier %q", identifier)
+	}
+	err = upsertResourceServerScope(api, id, scope, String(d, "description"), false)
+	if err != nil {
+		return err
+	}
+	d.SetId(identifier + "::" + scope)
+
+	return readResourceServerScope(d, m)
+}
+
+func readResourceServerScope(d *schema.ResourceData, m interface{}) error {
+	identifier, scope, err := parseResourceServerScopeID(d.Id())
+	if err != nil {
+		return err
+	}
+	d.Set("resource_server_identifier", identifier)
+
+	api := m.(*management.Management)
+	id, err := resourceServerScopeServerID(d, api)
+	if err != nil {
+		return err
+	}
+	if id == "" {
+		d.SetId("")
+		return nil
+	}
+
+	s, err := api.ResourceServer.Read(id)
+	if err != nil {
+		if mErr, ok := err.(management.Error); ok {
+			if mErr.Status() == http.StatusNotFound {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	for _, v := range s.Scopes {
+		if v.GetValue() == scope {
+			d.Set("scope", scope)
+			d.Set("description", v.Description)
+			return nil
+		}
+	}
+
+	// The resource server exists, but the scope was removed from it.
+	d.SetId("")
+	return nil
+}
+
+func updateResourceServerScope(d *schema.ResourceData, m interface{}) error {
+	api := m.(*management.Management)
+	id, err := resourceServerScopeServerID(d, api)
+	if err != nil {
+		return err
+	}
+	if id == "" {
+		return fmt.Errorf("no resource server found with identifier %q", d.Get("resource_server_identifier").(string))
+	}
+	err = upsertResourceServerScope(api, id, d.Get("scope").(string), String(d, "description"), false)
+	if err != nil {
+		return err
+	}
+	return readResourceServerScope(d, m)
+}
+
+func deleteResourceServerScope(d *schema.ResourceData, m interface{}) error {
+	api := m.(*management.Management)
+	id, err := resourceServerScopeServerID(d, api)
+	if err != nil {
+		return err
+	}
+	if id == "" {
+		d.SetId("")
+		return nil
+	}
+	err = upsertResourceServerScope(api, id, d.Get("scope").(string), nil, true)
+	if err != nil {
+		if mErr, ok := err.(management.Error); ok {
+			if mErr.Status() == http.StatusNotFound {
+				d.SetId("")
+				return nil
+			}
+		}
+	}
+	return err
+}
+
+func importResourceServerScope(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	identifier, scope, err := parseResourceServerScopeID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	d.Set("resource_server_identifier", identifier)
+	d.Set("scope", scope)
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceServerScopeServerID returns the ID of the resource server the scope
+// belongs to. Looking it up by identifier takes paging through all resource
+// servers, so it's only done once, and the ID is kept in state from then on.
+// If no resource server matches, it returns an empty ID without an error.
+func resourceServerScopeServerID(d *schema.ResourceData, api *management.Management) (string, error) {
+	if id := d.Get("resource_server_id").(string); id != "" {
+		return id, nil
+	}
+	s, err := findResourceServerByIdentifier(api, d.Get("resource_server_identifier").(string))
+	if err != nil || s == nil {
+		return "", err
+	}
+	d.Set("resource_server_id", s.GetID())
+	return s.GetID(), nil
+}
+
+// upsertResourceServerScope reads the current scopes of the resource server,
+// then adds or updates the given scope, or removes it, leaving all other scopes
+// untouched.
+func upsertResourceServerScope(api *management.Management, id, scope string, description *string, remove bool) error {
+	resourceServerScopeMutex.Lock(id)
+	defer resourceServerScopeMutex.Unlock(id)
+
+	s, err := api.ResourceServer.Read(id)
+	if err != nil {
+		return err
+	}
+
+	scopes := make([]*management.ResourceServerScope, 0, len(s.Scopes)+1)
+	for _, v := range s.Scopes {
+		if v.GetValue() != scope {
+			scopes = append(scopes, v)
+		}
+	}
+	if !remove {
+		scopes = append(scopes, &management.ResourceServerScope{
+			Value:       auth0.String(scope),
+			Description: description,
+		})
+	}
+
+	return api.Request("PATCH", api.URI("resource-servers", id), &resourceServerScopes{
+		Scopes: scopes,
+	})
+}
+
+// parseResourceServerScopeID splits the ID of an auth0_resource_server_scope
+// resource, formatted as resource_server_identifier::scope, into its parts. A
+// single colon can't be used as a separator, as both identifiers and scopes
+// commonly contain one.
+func parseResourceServerScopeID(id string) (identifier, scope string, err error) {
+	parts := strings.Split(id, "::")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid resource server scope ID %q, expected format resource_server_identifier::scope", id)
+	}
+	return parts[0], parts[1], nil
+}
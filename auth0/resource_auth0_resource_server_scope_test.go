@@ -0,0 +1,81 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/alexkappa/terraform-provider-auth0/auth0/internal/random"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccResourceServerScope(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(testAccResourceServerScopeCreate, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_resource_server_scope.read", "scope", "read:foo"),
+					resource.TestCheckResourceAttr("auth0_resource_server_scope.read", "description", "Can read Foo"),
+					resource.TestCheckResourceAttr("auth0_resource_server_scope.create", "scope", "create:foo"),
+					resource.TestCheckResourceAttr("auth0_resource_server.api", "scopes.#", "2"),
+					resource.TestCheckResourceAttrPair("auth0_resource_server_scope.read", "resource_server_id", "auth0_resource_server.api", "id"),
+				),
+			},
+			{
+				Config: random.Template(testAccResourceServerScopeUpdate, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_resource_server_scope.read", "description", "Can read all of Foo"),
+				),
+			},
+			{
+				ResourceName:      "auth0_resource_server_scope.read",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccResourceServerScopeAux = `
+
+resource auth0_resource_server api {
+	name = "Acceptance Test - {{.random}}"
+	identifier = "https://{{.random}}.acceptance.test.com/"
+}
+`
+
+const testAccResourceServerScopeCreate = testAccResourceServerScopeAux + `
+
+resource auth0_resource_server_scope read {
+	resource_server_identifier = auth0_resource_server.api.identifier
+	scope = "read:foo"
+	description = "Can read Foo"
+}
+
+resource auth0_resource_server_scope create {
+	resource_server_identifier = auth0_resource_server.api.identifier
+	scope = "create:foo"
+	description = "Can create Foo"
+}
+`
+
+const testAccResourceServerScopeUpdate = testAccResourceServerScopeAux + `
+
+resource auth0_resource_server_scope read {
+	resource_server_identifier = auth0_resource_server.api.identifier
+	scope = "read:foo"
+	description = "Can read all of Foo"
+}
+
+resource auth0_resource_server_scope create {
+	resource_server_identifier = auth0_resource_server.api.identifier
+	scope = "create:foo"
+	description = "Can create Foo"
+}
+`
@@ -0,0 +1,156 @@
+package auth0
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/management"
+)
+
+// rolePermissionIDSeparator separates the parts of the ID of an
+// auth0_role_permission resource. A single colon can't be used, as both
+// resource server identifiers and permission names commonly contain one.
+const rolePermissionIDSeparator = "::"
+
+func newRolePermission() *schema.Resource {
+	return &schema.Resource{
+
+		Create: createRolePermission,
+		Read:   readRolePermission,
+		Delete: deleteRolePermission,
+		Importer: &schema.ResourceImporter{
+			State: importRolePermission,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"role_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the role to associate the permission with",
+			},
+			"resource_server_identifier": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identifier of the resource server the permission belongs to",
+			},
+			"permission": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the permission (scope)",
+			},
+		},
+	}
+}
+
+func createRolePermission(d *schema.ResourceData, m interface{}) error {
+	roleID := d.Get("role_id").(string)
+	p := expandRolePermission(d)
+
+	api := m.(*management.Management)
+	if err := api.Role.AssociatePermissions(roleID, []*management.Permission{p}); err != nil {
+		return err
+	}
+	d.SetId(strings.Join([]string{
+		roleID,
+		auth0.StringValue(p.ResourceServerIdentifier),
+		auth0.StringValue(p.Name),
+	}, rolePermissionIDSeparator))
+
+	return readRolePermission(d, m)
+}
+
+func readRolePermission(d *schema.ResourceData, m interface{}) error {
+	roleID, identifier, name, err := parseRolePermissionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	api := m.(*management.Management)
+
+	var found bool
+	var page int
+	for !found {
+		l, err := api.Role.Permissions(roleID, management.Page(page))
+		if err != nil {
+			if mErr, ok := err.(management.Error); ok {
+				if mErr.Status() == http.StatusNotFound {
+					d.SetId("")
+					return nil
+				}
+			}
+			return err
+		}
+		for _, p := range l.Permissions {
+			if p.GetResourceServerIdentifier() == identifier && p.GetName() == name {
+				found = true
+			}
+		}
+		if !l.HasNext() {
+			break
+		}
+		page++
+	}
+
+	// The role exists, but the permission is no longer associated with it.
+	if !found {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("role_id", roleID)
+	d.Set("resource_server_identifier", identifier)
+	d.Set("permission", name)
+	return nil
+}
+
+func deleteRolePermission(d *schema.ResourceData, m interface{}) error {
+	roleID := d.Get("role_id").(string)
+	p := expandRolePermission(d)
+
+	api := m.(*management.Management)
+	err := api.Role.RemovePermissions(roleID, []*management.Permission{p})
+	if err != nil {
+		if mErr, ok := err.(management.Error); ok {
+			if mErr.Status() == http.StatusNotFound {
+				d.SetId("")
+				return nil
+			}
+		}
+	}
+	return err
+}
+
+func importRolePermission(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	roleID, identifier, name, err := parseRolePermissionID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	d.Set("role_id", roleID)
+	d.Set("resource_server_identifier", identifier)
+	d.Set("permission", name)
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandRolePermission(d *schema.ResourceData) *management.Permission {
+	return &management.Permission{
+		ResourceServerIdentifier: String(d, "resource_server_identifier"),
+		Name:                     String(d, "permission"),
+	}
+}
+
+// parseRolePermissionID splits the ID of an auth0_role_permission resource,
+// formatted as role_id::resource_server_identifier::permission, into its parts.
+func parseRolePermissionID(id string) (roleID, identifier, name string, err error) {
+	parts := strings.Split(id, rolePermissionIDSeparator)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("invalid role permission ID %q, expected format role_id::resource_server_identifier::permission", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
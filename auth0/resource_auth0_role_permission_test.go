@@ -0,0 +1,94 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/alexkappa/terraform-provider-auth0/auth0/internal/random"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccRolePermission(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(testAccRolePermission, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_role_permission.read", "permission", "read:foo"),
+					random.TestCheckResourceAttr("auth0_role_permission.read", "resource_server_identifier", "https://{{.random}}.acceptance.test.com/", rand),
+					resource.TestCheckResourceAttr("auth0_role_permission.create", "permission", "create:foo"),
+				),
+			},
+			{
+				ResourceName:      "auth0_role_permission.read",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccRolePermission = `
+
+resource auth0_resource_server api {
+	name = "Acceptance Test - {{.random}}"
+	identifier = "https://{{.random}}.acceptance.test.com/"
+	scopes {
+		value = "read:foo"
+		description = "Can read Foo"
+	}
+	scopes {
+		value = "create:foo"
+		description = "Can create Foo"
+	}
+}
+
+resource auth0_role role {
+	name = "Role - Acceptance Test - {{.random}}"
+	lifecycle {
+		ignore_changes = [ permissions ]
+	}
+}
+
+resource auth0_role_permission read {
+	role_id = auth0_role.role.id
+	resource_server_identifier = auth0_resource_server.api.identifier
+	permission = "read:foo"
+}
+
+resource auth0_role_permission create {
+	role_id = auth0_role.role.id
+	resource_server_identifier = auth0_resource_server.api.identifier
+	permission = "create:foo"
+}
+`
+
+func TestParseRolePermissionID(t *testing.T) {
+	for _, test := range []struct {
+		id         string
+		roleID     string
+		identifier string
+		name       string
+		err        bool
+	}{
+		{"rol_123::https://api.example.com/::read:foo", "rol_123", "https://api.example.com/", "read:foo", false},
+		{"rol_123::my-api::write", "rol_123", "my-api", "write", false},
+		{"rol_123:my-api:write", "", "", "", true},
+		{"rol_123::::write", "", "", "", true},
+	} {
+		roleID, identifier, name, err := parseRolePermissionID(test.id)
+		if test.err != (err != nil) {
+			t.Errorf("unexpected error for %q: %v", test.id, err)
+		}
+		if roleID != test.roleID || identifier != test.identifier || name != test.name {
+			t.Errorf("expected %q to be parsed to (%q, %q, %q), got (%q, %q, %q)",
+				test.id, test.roleID, test.identifier, test.name, roleID, identifier, name)
+		}
+	}
+}
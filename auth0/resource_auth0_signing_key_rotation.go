@@ -0,0 +1,119 @@
+package auth0
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/management"
+)
+
+func newSigningKeyRotation() *schema.Resource {
+	return &schema.Resource{
+
+		Create: createSigningKeyRotation,
+		Read:   readSigningKeyRotation,
+		Update: updateSigningKeyRotation,
+		Delete: deleteSigningKeyRotation,
+
+		Schema: map[string]*schema.Schema{
+			"rotation_trigger": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Arbitrary map of values which, when changed, rotates the signing keys",
+			},
+			"revoke_previous": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to revoke the previous key after rotating",
+			},
+			"current_kid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The key ID of the current signing key",
+			},
+			"previous_kid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The key ID of the previous signing key",
+			},
+		},
+	}
+}
+
+// createSigningKeyRotation doesn't rotate the keys, only later changes to the
+// rotation trigger do, so that adding the resource never revokes the key that
+// is in use.
+func createSigningKeyRotation(d *schema.ResourceData, m interface{}) error {
+	d.SetId(resource.UniqueId())
+	return readSigningKeyRotation(d, m)
+}
+
+func readSigningKeyRotation(d *schema.ResourceData, m interface{}) error {
+	api := m.(*management.Management)
+	keys, err := readSigningKeys(api)
+	if err != nil {
+		return err
+	}
+	d.Set("current_kid", "")
+	d.Set("previous_kid", "")
+	for _, k := range keys {
+		if auth0.BoolValue(k.Current) {
+			d.Set("current_kid", k.KID)
+		}
+		if auth0.BoolValue(k.Previous) {
+			d.Set("previous_kid", k.KID)
+		}
+	}
+	return nil
+}
+
+func updateSigningKeyRotation(d *schema.ResourceData, m interface{}) error {
+	d.Partial(true)
+	if err := rotateSigningKeys(d, m); err != nil {
+		return err
+	}
+	d.Partial(false)
+	return readSigningKeyRotation(d, m)
+}
+
+func deleteSigningKeyRotation(d *schema.ResourceData, m interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+// rotateSigningKeys rotates the signing keys of the tenant when the rotation
+// trigger changes. The next key becomes current, and the current key becomes
+// previous, which is then revoked if revoke_previous is set.
+func rotateSigningKeys(d *schema.ResourceData, m interface{}) error {
+	if d.HasChange("rotation_trigger") {
+		api := m.(*management.Management)
+
+		keys, err := readSigningKeys(api)
+		if err != nil {
+			return err
+		}
+		var previous *signingKey
+		for _, k := range keys {
+			if auth0.BoolValue(k.Current) {
+				previous = k
+			}
+		}
+
+		var rotated signingKey
+		if err := api.Request("POST", api.URI("keys", "signing", "rotate"), &rotated); err != nil {
+			return err
+		}
+
+		if d.Get("revoke_previous").(bool) && previous != nil {
+			var revoked signingKey
+			err := api.Request("PUT", api.URI("keys", "signing", auth0.StringValue(previous.KID), "revoke"), &revoked)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	d.SetPartial("rotation_trigger")
+	return nil
+}
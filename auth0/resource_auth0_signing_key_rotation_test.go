@@ -0,0 +1,88 @@
+package auth0
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"gopkg.in/auth0.v5"
+)
+
+func TestAccSigningKeyRotation(t *testing.T) {
+
+	var kid, previousKID string
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				PreConfig: testAccSigningKeyRotationPreviousKID(t, &previousKID),
+				Config:    testAccSigningKeyRotationCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("auth0_signing_key_rotation.rotation", "current_kid"),
+					resource.TestCheckResourceAttrPtr("auth0_signing_key_rotation.rotation", "previous_kid", &previousKID),
+					testAccSigningKeyRotationCurrentKID("auth0_signing_key_rotation.rotation", &kid),
+				),
+			},
+			{
+				Config: testAccSigningKeyRotationUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("auth0_signing_key_rotation.rotation", "previous_kid", &kid),
+				),
+			},
+		},
+	})
+}
+
+// testAccSigningKeyRotationPreviousKID records the previous key ID before the
+// resource is created, which must not rotate the keys.
+func testAccSigningKeyRotationPreviousKID(t *testing.T, kid *string) func() {
+	return func() {
+		api, err := Auth0()
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys, err := readSigningKeys(api)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, k := range keys {
+			if auth0.BoolValue(k.Previous) {
+				*kid = auth0.StringValue(k.KID)
+			}
+		}
+	}
+}
+
+func testAccSigningKeyRotationCurrentKID(name string, kid *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", name)
+		}
+		*kid = rs.Primary.Attributes["current_kid"]
+		return nil
+	}
+}
+
+const testAccSigningKeyRotationCreate = `
+
+resource "auth0_signing_key_rotation" "rotation" {
+  rotation_trigger = {
+    date = "2021-01-01"
+  }
+}
+`
+
+const testAccSigningKeyRotationUpdate = `
+
+resource "auth0_signing_key_rotation" "rotation" {
+  rotation_trigger = {
+    date = "2021-04-01"
+  }
+}
+`
@@ -0,0 +1,196 @@
+package auth0
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/management"
+)
+
+// suspiciousIPThrottling holds the suspicious IP throttling settings of the
+// tenant, with separate limits for each stage of authentication.
+type suspiciousIPThrottling struct {
+	Enabled   *bool                        `json:"enabled,omitempty"`
+	Shields   []interface{}                `json:"shields,omitempty"`
+	Allowlist []interface{}                `json:"allowlist"`
+	Stage     *suspiciousIPThrottlingStage `json:"stage,omitempty"`
+}
+
+type suspiciousIPThrottlingStage struct {
+	PreLogin            *suspiciousIPThrottlingLimit `json:"pre-login,omitempty"`
+	PreUserRegistration *suspiciousIPThrottlingLimit `json:"pre-user-registration,omitempty"`
+}
+
+type suspiciousIPThrottlingLimit struct {
+	MaxAttempts *int `json:"max_attempts,omitempty"`
+	Rate        *int `json:"rate,omitempty"`
+}
+
+func newSuspiciousIPThrottling() *schema.Resource {
+	return &schema.Resource{
+
+		Create: createSuspiciousIPThrottling,
+		Read:   readSuspiciousIPThrottling,
+		Update: updateSuspiciousIPThrottling,
+		Delete: deleteSuspiciousIPThrottling,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether suspicious IP throttling is enabled",
+			},
+			"shields": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"block", "admin_notification",
+					}, false),
+				},
+				Description: "Action to take when a suspicious IP address is detected. " +
+					"Options include `block` and `admin_notification`",
+			},
+			"allowlist": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.Any(validation.IsIPAddress, validation.IsCIDR),
+				},
+				Description: "IP addresses or CIDR ranges that are never throttled",
+			},
+			"pre_login": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem:        suspiciousIPThrottlingLimitSchema(),
+				Description: "Throttling limits for login attempts",
+			},
+			"pre_user_registration": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem:        suspiciousIPThrottlingLimitSchema(),
+				Description: "Throttling limits for sign up attempts",
+			},
+		},
+	}
+}
+
+func suspiciousIPThrottlingLimitSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"max_attempts": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Total number of attempts allowed from a single IP address",
+			},
+			"rate": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Interval of time, in milliseconds, at which new attempts are granted",
+			},
+		},
+	}
+}
+
+func createSuspiciousIPThrottling(d *schema.ResourceData, m interface{}) error {
+	d.SetId(resource.UniqueId())
+	return updateSuspiciousIPThrottling(d, m)
+}
+
+func readSuspiciousIPThrottling(d *schema.ResourceData, m interface{}) error {
+	api := m.(*management.Management)
+	var s suspiciousIPThrottling
+	err := api.Request("GET", api.URI("attack-protection", "suspicious-ip-throttling"), &s)
+	if err != nil {
+		if mErr, ok := err.(management.Error); ok {
+			if mErr.Status() == http.StatusNotFound {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+	d.Set("enabled", s.Enabled)
+	d.Set("shields", s.Shields)
+	d.Set("allowlist", s.Allowlist)
+	if s.Stage != nil {
+		d.Set("pre_login", flattenSuspiciousIPThrottlingLimit(s.Stage.PreLogin))
+		d.Set("pre_user_registration", flattenSuspiciousIPThrottlingLimit(s.Stage.PreUserRegistration))
+	}
+	return nil
+}
+
+func updateSuspiciousIPThrottling(d *schema.ResourceData, m interface{}) error {
+	s := buildSuspiciousIPThrottling(d)
+	api := m.(*management.Management)
+	err := api.Request("PATCH", api.URI("attack-protection", "suspicious-ip-throttling"), s)
+	if err != nil {
+		return err
+	}
+	return readSuspiciousIPThrottling(d, m)
+}
+
+func deleteSuspiciousIPThrottling(d *schema.ResourceData, m interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+func buildSuspiciousIPThrottling(d *schema.ResourceData) *suspiciousIPThrottling {
+	s := &suspiciousIPThrottling{
+		Enabled:   Bool(d, "enabled"),
+		Shields:   Set(d, "shields", IsNewResource(), HasChange()).List(),
+		Allowlist: Set(d, "allowlist").List(),
+		Stage:     &suspiciousIPThrottlingStage{},
+	}
+
+	List(d, "pre_login").Elem(func(d ResourceData) {
+		s.Stage.PreLogin = &suspiciousIPThrottlingLimit{
+			MaxAttempts: Int(d, "max_attempts"),
+			Rate:        Int(d, "rate"),
+		}
+	})
+
+	List(d, "pre_user_registration").Elem(func(d ResourceData) {
+		s.Stage.PreUserRegistration = &suspiciousIPThrottlingLimit{
+			MaxAttempts: Int(d, "max_attempts"),
+			Rate:        Int(d, "rate"),
+		}
+	})
+
+	if s.Stage.PreLogin == nil && s.Stage.PreUserRegistration == nil {
+		s.Stage = nil
+	}
+
+	return s
+}
+
+func flattenSuspiciousIPThrottlingLimit(l *suspiciousIPThrottlingLimit) []interface{} {
+	if l == nil {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"max_attempts": auth0.IntValue(l.MaxAttempts),
+			"rate":         auth0.IntValue(l.Rate),
+		},
+	}
+}
@@ -0,0 +1,103 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccSuspiciousIPThrottling(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSuspiciousIPThrottlingCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_suspicious_ip_throttling.my_throttling", "enabled", "true"),
+					resource.TestCheckResourceAttr("auth0_suspicious_ip_throttling.my_throttling", "shields.#", "2"),
+					resource.TestCheckResourceAttr("auth0_suspicious_ip_throttling.my_throttling", "pre_login.0.max_attempts", "100"),
+					resource.TestCheckResourceAttr("auth0_suspicious_ip_throttling.my_throttling", "pre_login.0.rate", "864000"),
+				),
+			},
+			{
+				Config: testAccSuspiciousIPThrottlingUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_suspicious_ip_throttling.my_throttling", "shields.#", "1"),
+					resource.TestCheckResourceAttr("auth0_suspicious_ip_throttling.my_throttling", "allowlist.#", "1"),
+					resource.TestCheckResourceAttr("auth0_suspicious_ip_throttling.my_throttling", "pre_user_registration.0.max_attempts", "50"),
+					resource.TestCheckResourceAttr("auth0_suspicious_ip_throttling.my_throttling", "pre_user_registration.0.rate", "1200"),
+				),
+			},
+			{
+				Config: testAccSuspiciousIPThrottlingClearAllowlist,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_suspicious_ip_throttling.my_throttling", "shields.#", "1"),
+					resource.TestCheckResourceAttr("auth0_suspicious_ip_throttling.my_throttling", "allowlist.#", "0"),
+				),
+			},
+			{
+				Config: testAccSuspiciousIPThrottlingWithoutShields,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_suspicious_ip_throttling.without_shields", "enabled", "true"),
+					resource.TestCheckResourceAttr("auth0_suspicious_ip_throttling.without_shields", "shields.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccSuspiciousIPThrottlingCreate = `
+
+resource "auth0_suspicious_ip_throttling" "my_throttling" {
+  enabled = true
+  shields = [ "block", "admin_notification" ]
+  pre_login {
+    max_attempts = 100
+    rate = 864000
+  }
+}
+`
+
+const testAccSuspiciousIPThrottlingUpdate = `
+
+resource "auth0_suspicious_ip_throttling" "my_throttling" {
+  enabled = true
+  shields = [ "admin_notification" ]
+  allowlist = [ "192.168.1.0/24" ]
+  pre_login {
+    max_attempts = 100
+    rate = 864000
+  }
+  pre_user_registration {
+    max_attempts = 50
+    rate = 1200
+  }
+}
+`
+
+const testAccSuspiciousIPThrottlingClearAllowlist = `
+
+resource "auth0_suspicious_ip_throttling" "my_throttling" {
+  enabled = true
+  shields = [ "admin_notification" ]
+  pre_login {
+    max_attempts = 100
+    rate = 864000
+  }
+  pre_user_registration {
+    max_attempts = 50
+    rate = 1200
+  }
+}
+`
+
+const testAccSuspiciousIPThrottlingWithoutShields = `
+
+resource "auth0_suspicious_ip_throttling" "without_shields" {
+  enabled = true
+}
+`
@@ -0,0 +1,185 @@
+package auth0
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/management"
+)
+
+type actionBinding struct {
+	ID          *string           `json:"id,omitempty"`
+	TriggerID   *string           `json:"trigger_id,omitempty"`
+	DisplayName *string           `json:"display_name,omitempty"`
+	Ref         *actionBindingRef `json:"ref,omitempty"`
+	Action      *action           `json:"action,omitempty"`
+}
+
+type actionBindingRef struct {
+	Type  *string `json:"type,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
+
+type actionBindingList struct {
+	Bindings []*actionBinding `json:"bindings"`
+	Total    int              `json:"total,omitempty"`
+	Page     int              `json:"page,omitempty"`
+	PerPage  int              `json:"per_page,omitempty"`
+}
+
+// actionBindingsPerPage is the number of bindings requested per page, the
+// most the actions API allows.
+const actionBindingsPerPage = 50
+
+// HasNext reports whether there are more bindings to retrieve. Unlike most
+// endpoints, the actions API reports pages rather than offsets. When the page
+// size isn't reported, the size of the page received is used instead, and an
+// empty page is always the last.
+func (l actionBindingList) HasNext() bool {
+	perPage := l.PerPage
+	if perPage == 0 {
+		perPage = len(l.Bindings)
+	}
+	if perPage == 0 {
+		return false
+	}
+	return l.Total > (l.Page+1)*perPage
+}
+
+func newTriggerBinding() *schema.Resource {
+	return &schema.Resource{
+
+		Create: createTriggerBinding,
+		Read:   readTriggerBinding,
+		Update: updateTriggerBinding,
+		Delete: deleteTriggerBinding,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"trigger": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(actionTriggers, false),
+				Description:  "The ID of the trigger to bind with",
+			},
+			"actions": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Action ID",
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the binding",
+						},
+					},
+				},
+				Description: "The actions bound to this trigger, in the order they are executed",
+			},
+		},
+	}
+}
+
+func createTriggerBinding(d *schema.ResourceData, m interface{}) error {
+	d.SetId(d.Get("trigger").(string))
+	return updateTriggerBinding(d, m)
+}
+
+func readTriggerBinding(d *schema.ResourceData, m interface{}) error {
+	api := m.(*management.Management)
+
+	var bindings []*actionBinding
+	var page int
+	for {
+		var l actionBindingList
+		err := api.Request("GET", api.URI("actions", "triggers", d.Id(), "bindings"), &l,
+			management.Page(page),
+			management.PerPage(actionBindingsPerPage))
+		if err != nil {
+			if mErr, ok := err.(management.Error); ok {
+				if mErr.Status() == http.StatusNotFound {
+					d.SetId("")
+					return nil
+				}
+			}
+			return err
+		}
+		bindings = append(bindings, l.Bindings...)
+		if !l.HasNext() {
+			break
+		}
+		page++
+	}
+
+	d.Set("trigger", d.Id())
+	d.Set("actions", flattenTriggerBindingActions(bindings))
+	return nil
+}
+
+func updateTriggerBinding(d *schema.ResourceData, m interface{}) error {
+	bindings := buildTriggerBindings(d)
+	api := m.(*management.Management)
+	err := api.Request("PATCH", api.URI("actions", "triggers", d.Id(), "bindings"), &actionBindingList{
+		Bindings: bindings,
+	})
+	if err != nil {
+		return err
+	}
+	return readTriggerBinding(d, m)
+}
+
+func deleteTriggerBinding(d *schema.ResourceData, m interface{}) error {
+	api := m.(*management.Management)
+	err := api.Request("PATCH", api.URI("actions", "triggers", d.Id(), "bindings"), &actionBindingList{
+		Bindings: []*actionBinding{},
+	})
+	if err != nil {
+		if mErr, ok := err.(management.Error); ok {
+			if mErr.Status() == http.StatusNotFound {
+				d.SetId("")
+				return nil
+			}
+		}
+	}
+	return err
+}
+
+func buildTriggerBindings(d *schema.ResourceData) []*actionBinding {
+	bindings := []*actionBinding{}
+	List(d, "actions").Elem(func(d ResourceData) {
+		bindings = append(bindings, &actionBinding{
+			DisplayName: String(d, "display_name"),
+			Ref: &actionBindingRef{
+				Type:  auth0.String("action_id"),
+				Value: String(d, "id"),
+			},
+		})
+	})
+	return bindings
+}
+
+func flattenTriggerBindingActions(bindings []*actionBinding) []interface{} {
+	var v []interface{}
+	for _, b := range bindings {
+		var id string
+		if b.Action != nil {
+			id = auth0.StringValue(b.Action.ID)
+		}
+		v = append(v, map[string]interface{}{
+			"id":           id,
+			"display_name": auth0.StringValue(b.DisplayName),
+		})
+	}
+	return v
+}
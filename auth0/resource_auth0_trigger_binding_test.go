@@ -0,0 +1,111 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/alexkappa/terraform-provider-auth0/auth0/internal/random"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccTriggerBinding(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(testAccTriggerBindingCreate, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_trigger_binding.login_flow", "trigger", "post-login"),
+					resource.TestCheckResourceAttr("auth0_trigger_binding.login_flow", "actions.#", "1"),
+					random.TestCheckResourceAttr("auth0_trigger_binding.login_flow", "actions.0.display_name", "First {{.random}}", rand),
+				),
+			},
+			{
+				Config: random.Template(testAccTriggerBindingUpdate, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_trigger_binding.login_flow", "actions.#", "2"),
+					random.TestCheckResourceAttr("auth0_trigger_binding.login_flow", "actions.0.display_name", "Second {{.random}}", rand),
+					random.TestCheckResourceAttr("auth0_trigger_binding.login_flow", "actions.1.display_name", "First {{.random}}", rand),
+				),
+			},
+		},
+	})
+}
+
+const testAccTriggerBindingAux = `
+
+resource auth0_action first {
+	name = "Test Action First {{.random}}"
+	supported_triggers {
+		id = "post-login"
+		version = "v2"
+	}
+	code = <<-EOT
+	exports.onExecutePostLogin = async (event, api) => {
+		console.log("first")
+	};
+	EOT
+}
+
+resource auth0_action second {
+	name = "Test Action Second {{.random}}"
+	supported_triggers {
+		id = "post-login"
+		version = "v2"
+	}
+	code = <<-EOT
+	exports.onExecutePostLogin = async (event, api) => {
+		console.log("second")
+	};
+	EOT
+}
+`
+
+const testAccTriggerBindingCreate = testAccTriggerBindingAux + `
+
+resource auth0_trigger_binding login_flow {
+	trigger = "post-login"
+	actions {
+		id = auth0_action.first.id
+		display_name = "First {{.random}}"
+	}
+}
+`
+
+const testAccTriggerBindingUpdate = testAccTriggerBindingAux + `
+
+resource auth0_trigger_binding login_flow {
+	trigger = "post-login"
+	actions {
+		id = auth0_action.second.id
+		display_name = "Second {{.random}}"
+	}
+	actions {
+		id = auth0_action.first.id
+		display_name = "First {{.random}}"
+	}
+}
+`
+
+func TestActionBindingListHasNext(t *testing.T) {
+	for _, test := range []struct {
+		list    actionBindingList
+		hasNext bool
+	}{
+		{actionBindingList{Total: 3, Page: 0, PerPage: 2}, true},
+		{actionBindingList{Total: 3, Page: 1, PerPage: 2}, false},
+		{actionBindingList{Total: 2, Page: 0, PerPage: 2}, false},
+		{actionBindingList{Total: 3, Page: 0, Bindings: make([]*actionBinding, 2)}, true},
+		{actionBindingList{Total: 4, Page: 1, Bindings: make([]*actionBinding, 2)}, false},
+		{actionBindingList{Total: 3, Page: 5}, false},
+	} {
+		if hasNext := test.list.HasNext(); hasNext != test.hasNext {
+			t.Errorf("expected HasNext to be %t for %+v, got %t", test.hasNext, test.list, hasNext)
+		}
+	}
+}
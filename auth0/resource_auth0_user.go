@@ -156,20 +156,38 @@ func readUser(d *schema.ResourceData, m interface{}) error {
 	}
 	d.Set("app_metadata", appMeta)
 
-	l, err := api.User.Roles(d.Id())
+	roles, err := readUserRoles(api, d.Id())
 	if err != nil {
 		return err
 	}
-	d.Set("roles", func() (v []interface{}) {
-		for _, role := range l.Roles {
-			v = append(v, auth0.StringValue(role.ID))
-		}
-		return
-	}())
+	d.Set("roles", flattenUserRoles(roles))
 
 	return nil
 }
 
+func readUserRoles(api *management.Management, id string) (roles []*management.Role, err error) {
+	var page int
+	for {
+		l, err := api.User.Roles(id, management.Page(page))
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, l.Roles...)
+		if !l.HasNext() {
+			break
+		}
+		page++
+	}
+	return roles, nil
+}
+
+func flattenUserRoles(roles []*management.Role) (v []interface{}) {
+	for _, role := range roles {
+		v = append(v, auth0.StringValue(role.ID))
+	}
+	return
+}
+
 func createUser(d *schema.ResourceData, m interface{}) error {
 	u, err := buildUser(d)
 	if err != nil {
@@ -0,0 +1,160 @@
+package auth0
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/management"
+)
+
+func newUserPermissions() *schema.Resource {
+	return &schema.Resource{
+
+		Create: createUserPermissions,
+		Read:   readUserPermissions,
+		Update: updateUserPermissions,
+		Delete: deleteUserPermissions,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the user to assign the permissions to",
+			},
+			"permissions": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"resource_server_identifier": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+				Description: "Permissions (scopes) assigned directly to the user",
+			},
+		},
+	}
+}
+
+func createUserPermissions(d *schema.ResourceData, m interface{}) error {
+	d.SetId(d.Get("user_id").(string))
+
+	d.Partial(true)
+	if err := assignUserPermissions(d, m); err != nil {
+		return err
+	}
+	d.Partial(false)
+
+	return readUserPermissions(d, m)
+}
+
+func readUserPermissions(d *schema.ResourceData, m interface{}) error {
+	api := m.(*management.Management)
+
+	var permissions []*management.Permission
+	var page int
+	for {
+		l, err := api.User.Permissions(d.Id(), management.Page(page))
+		if err != nil {
+			if mErr, ok := err.(management.Error); ok {
+				if mErr.Status() == http.StatusNotFound {
+					d.SetId("")
+					return nil
+				}
+			}
+			return err
+		}
+		permissions = append(permissions, l.Permissions...)
+		if !l.HasNext() {
+			break
+		}
+		page++
+	}
+
+	d.Set("user_id", d.Id())
+	d.Set("permissions", flattenRolePermissions(permissions))
+	return nil
+}
+
+func updateUserPermissions(d *schema.ResourceData, m interface{}) error {
+	d.Partial(true)
+	if err := assignUserPermissions(d, m); err != nil {
+		return err
+	}
+	d.Partial(false)
+
+	return readUserPermissions(d, m)
+}
+
+func deleteUserPermissions(d *schema.ResourceData, m interface{}) error {
+	var permissions []*management.Permission
+	for _, v := range Set(d, "permissions").List() {
+		permissions = append(permissions, expandUserPermission(v))
+	}
+
+	api := m.(*management.Management)
+	err := api.User.RemovePermissions(d.Id(), permissions)
+	if err != nil {
+		if mErr, ok := err.(management.Error); ok {
+			if mErr.Status() == http.StatusNotFound {
+				d.SetId("")
+				return nil
+			}
+		}
+	}
+	return err
+}
+
+func assignUserPermissions(d *schema.ResourceData, m interface{}) error {
+
+	add, rm := Diff(d, "permissions")
+
+	var addPermissions []*management.Permission
+	for _, addPermission := range add {
+		addPermissions = append(addPermissions, expandUserPermission(addPermission))
+	}
+
+	var rmPermissions []*management.Permission
+	for _, rmPermission := range rm {
+		rmPermissions = append(rmPermissions, expandUserPermission(rmPermission))
+	}
+
+	api := m.(*management.Management)
+
+	if len(rmPermissions) > 0 {
+		err := api.User.RemovePermissions(d.Id(), rmPermissions)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(addPermissions) > 0 {
+		err := api.User.AssignPermissions(d.Id(), addPermissions)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetPartial("permissions")
+	return nil
+}
+
+func expandUserPermission(v interface{}) *management.Permission {
+	permission := v.(map[string]interface{})
+	return &management.Permission{
+		Name:                     auth0.String(permission["name"].(string)),
+		ResourceServerIdentifier: auth0.String(permission["resource_server_identifier"].(string)),
+	}
+}
@@ -0,0 +1,84 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/alexkappa/terraform-provider-auth0/auth0/internal/random"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccUserPermissions(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(testAccUserPermissionsCreate, rand),
+				Check: resource.ComposeTestCheckFunc(
+					random.TestCheckResourceAttr("auth0_user_permissions.user_permissions", "user_id", "auth0|{{.random}}", rand),
+					resource.TestCheckResourceAttr("auth0_user_permissions.user_permissions", "permissions.#", "1"),
+				),
+			},
+			{
+				Config: random.Template(testAccUserPermissionsUpdate, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_user_permissions.user_permissions", "permissions.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+const testAccUserPermissionsAux = `
+
+resource auth0_user user {
+	connection_name = "Username-Password-Authentication"
+	user_id = "{{.random}}"
+	email = "{{.random}}@acceptance.test.com"
+	password = "passpass$12$12"
+}
+
+resource auth0_resource_server api {
+	name = "Acceptance Test - {{.random}}"
+	identifier = "https://{{.random}}.acceptance.test.com/"
+	scopes {
+		value = "read:foo"
+		description = "Can read Foo"
+	}
+	scopes {
+		value = "create:foo"
+		description = "Can create Foo"
+	}
+}
+`
+
+const testAccUserPermissionsCreate = testAccUserPermissionsAux + `
+
+resource auth0_user_permissions user_permissions {
+	user_id = auth0_user.user.id
+	permissions {
+		name = "read:foo"
+		resource_server_identifier = auth0_resource_server.api.identifier
+	}
+}
+`
+
+const testAccUserPermissionsUpdate = testAccUserPermissionsAux + `
+
+resource auth0_user_permissions user_permissions {
+	user_id = auth0_user.user.id
+	permissions {
+		name = "read:foo"
+		resource_server_identifier = auth0_resource_server.api.identifier
+	}
+	permissions {
+		name = "create:foo"
+		resource_server_identifier = auth0_resource_server.api.identifier
+	}
+}
+`
@@ -0,0 +1,99 @@
+package auth0
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"gopkg.in/auth0.v5"
+	"gopkg.in/auth0.v5/management"
+)
+
+func newUserRole() *schema.Resource {
+	return &schema.Resource{
+
+		Create: createUserRole,
+		Read:   readUserRole,
+		Update: updateUserRole,
+		Delete: deleteUserRole,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the user to assign the roles to",
+			},
+			"roles": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the roles assigned to the user",
+			},
+		},
+	}
+}
+
+func createUserRole(d *schema.ResourceData, m interface{}) error {
+	d.SetId(d.Get("user_id").(string))
+
+	d.Partial(true)
+	if err := assignUserRoles(d, m); err != nil {
+		return err
+	}
+	d.Partial(false)
+
+	return readUserRole(d, m)
+}
+
+func readUserRole(d *schema.ResourceData, m interface{}) error {
+	api := m.(*management.Management)
+	roles, err := readUserRoles(api, d.Id())
+	if err != nil {
+		if mErr, ok := err.(management.Error); ok {
+			if mErr.Status() == http.StatusNotFound {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	d.Set("user_id", d.Id())
+	d.Set("roles", flattenUserRoles(roles))
+	return nil
+}
+
+func updateUserRole(d *schema.ResourceData, m interface{}) error {
+	d.Partial(true)
+	if err := assignUserRoles(d, m); err != nil {
+		return err
+	}
+	d.Partial(false)
+
+	return readUserRole(d, m)
+}
+
+func deleteUserRole(d *schema.ResourceData, m interface{}) error {
+	var roles []*management.Role
+	for _, role := range Set(d, "roles").List() {
+		roles = append(roles, &management.Role{
+			ID: auth0.String(role.(string)),
+		})
+	}
+
+	api := m.(*management.Management)
+	err := api.User.RemoveRoles(d.Id(), roles)
+	if err != nil {
+		if mErr, ok := err.(management.Error); ok {
+			if mErr.Status() == http.StatusNotFound {
+				d.SetId("")
+				return nil
+			}
+		}
+	}
+	return err
+}
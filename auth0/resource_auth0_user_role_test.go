@@ -0,0 +1,74 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/alexkappa/terraform-provider-auth0/auth0/internal/random"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccUserRole(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(testAccUserRoleCreate, rand),
+				Check: resource.ComposeTestCheckFunc(
+					random.TestCheckResourceAttr("auth0_user_role.user_roles", "user_id", "auth0|{{.random}}", rand),
+					resource.TestCheckResourceAttr("auth0_user_role.user_roles", "roles.#", "1"),
+				),
+			},
+			{
+				Config: random.Template(testAccUserRoleUpdate, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_user_role.user_roles", "roles.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "auth0_user_role.user_roles",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccUserRoleAux = `
+
+resource auth0_user user {
+	connection_name = "Username-Password-Authentication"
+	user_id = "{{.random}}"
+	email = "{{.random}}@acceptance.test.com"
+	password = "passpass$12$12"
+}
+
+resource auth0_role reader {
+	name = "Reader - Acceptance Test - {{.random}}"
+}
+
+resource auth0_role writer {
+	name = "Writer - Acceptance Test - {{.random}}"
+}
+`
+
+const testAccUserRoleCreate = testAccUserRoleAux + `
+
+resource auth0_user_role user_roles {
+	user_id = auth0_user.user.id
+	roles = [ auth0_role.reader.id ]
+}
+`
+
+const testAccUserRoleUpdate = testAccUserRoleAux + `
+
+resource auth0_user_role user_roles {
+	user_id = auth0_user.user.id
+	roles = [ auth0_role.reader.id, auth0_role.writer.id ]
+}
+`
@@ -0,0 +1,356 @@
+package auth0
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"gopkg.in/auth0.v5/management"
+)
+
+// requiredScopes lists the scopes of the Management API each operation on a
+// resource needs.
+type requiredScopes struct {
+	read   []string
+	create []string
+	update []string
+	delete []string
+
+	// changed returns the further scopes needed to apply a planned create,
+	// update or replacement, for operations made only when some attributes
+	// change.
+	changed func(d *schema.ResourceDiff) []string
+}
+
+// managedScopes returns the scopes needed by a resource created, updated and
+// deleted through the endpoints of the given kind of entity.
+func managedScopes(entity string) requiredScopes {
+	return requiredScopes{
+		read:   []string{"read:" + entity},
+		create: []string{"create:" + entity},
+		update: []string{"update:" + entity},
+		delete: []string{"delete:" + entity},
+	}
+}
+
+// settingsScopes returns the scopes needed by a resource managing settings,
+// which are updated when the resource is created, and left as they are when
+// it's deleted.
+func settingsScopes(entity string) requiredScopes {
+	return requiredScopes{
+		read:   []string{"read:" + entity},
+		create: []string{"update:" + entity},
+		update: []string{"update:" + entity},
+	}
+}
+
+// associationScopes returns the scopes needed by a resource associating two
+// entities, by updating the one with the given kind.
+func associationScopes(entity string) requiredScopes {
+	return requiredScopes{
+		read:   []string{"read:" + entity},
+		create: []string{"update:" + entity},
+		update: []string{"update:" + entity},
+		delete: []string{"update:" + entity},
+	}
+}
+
+// clientSecretRotationScopes returns the scopes needed to rotate the secret of
+// a client, which only happens when the rotation trigger changes.
+func clientSecretRotationScopes(d *schema.ResourceDiff) []string {
+	if d.HasChange("client_secret_rotation_trigger") {
+		return []string{"update:client_keys"}
+	}
+	return nil
+}
+
+// resourceScopes lists the scopes needed by each resource of the provider.
+// They follow every endpoint an operation calls, including the reads and
+// associations made after an entity is created.
+var resourceScopes = map[string]requiredScopes{
+	"auth0_client": {
+		read:    []string{"read:clients"},
+		create:  []string{"create:clients"},
+		update:  []string{"update:clients"},
+		delete:  []string{"delete:clients"},
+		changed: clientSecretRotationScopes,
+	},
+	"auth0_global_client": {
+		read:    []string{"read:clients"},
+		create:  []string{"update:clients"},
+		update:  []string{"update:clients"},
+		changed: clientSecretRotationScopes,
+	},
+	"auth0_client_grant": managedScopes("client_grants"),
+	"auth0_client_credentials": {
+		read:   []string{"read:client_credentials"},
+		create: []string{"create:client_credentials", "read:clients", "update:clients"},
+		update: []string{"update:client_credentials"},
+		delete: []string{"delete:client_credentials", "read:clients", "update:clients"},
+	},
+	"auth0_connection":            managedScopes("connections"),
+	"auth0_connection_client":     associationScopes("connections"),
+	"auth0_custom_domain":         managedScopes("custom_domains"),
+	"auth0_resource_server":       managedScopes("resource_servers"),
+	"auth0_resource_server_scope": associationScopes("resource_servers"),
+	"auth0_signing_key_rotation": {
+		read:   []string{"read:signing_keys"},
+		update: []string{"create:signing_keys", "update:signing_keys"},
+	},
+	"auth0_rule": managedScopes("rules"),
+	"auth0_rule_config": {
+		read:   []string{"read:rules_configs"},
+		create: []string{"update:rules_configs"},
+		update: []string{"update:rules_configs"},
+		delete: []string{"delete:rules_configs"},
+	},
+	"auth0_hook": {
+		read:   []string{"read:hooks"},
+		create: []string{"create:hooks", "update:hooks"},
+		update: []string{"update:hooks"},
+		delete: []string{"delete:hooks"},
+	},
+	"auth0_prompt":             settingsScopes("prompts"),
+	"auth0_prompt_custom_text": associationScopes("prompts"),
+	"auth0_email":              managedScopes("email_provider"),
+	"auth0_email_template": {
+		read:   []string{"read:email_templates"},
+		create: []string{"create:email_templates", "update:email_templates"},
+		update: []string{"update:email_templates"},
+		delete: []string{"update:email_templates"},
+	},
+	"auth0_user": {
+		read:   []string{"read:users", "read:roles"},
+		create: []string{"create:users", "update:users"},
+		update: []string{"update:users"},
+		delete: []string{"delete:users"},
+	},
+	"auth0_user_role": {
+		read:   []string{"read:users", "read:roles"},
+		create: []string{"update:users"},
+		update: []string{"update:users"},
+		delete: []string{"update:users"},
+	},
+	"auth0_user_permissions": associationScopes("users"),
+	"auth0_tenant":           settingsScopes("tenant_settings"),
+	"auth0_role": {
+		read:   []string{"read:roles"},
+		create: []string{"create:roles", "update:roles"},
+		update: []string{"update:roles"},
+		delete: []string{"delete:roles"},
+	},
+	"auth0_role_permission": associationScopes("roles"),
+	"auth0_log_stream": {
+		read:   []string{"read:log_streams"},
+		create: []string{"create:log_streams", "update:log_streams"},
+		update: []string{"update:log_streams"},
+		delete: []string{"delete:log_streams"},
+	},
+	"auth0_guardian": {
+		read:   []string{"read:guardian_factors", "read:mfa_policies"},
+		create: []string{"update:guardian_factors", "update:mfa_policies"},
+		update: []string{"update:guardian_factors", "update:mfa_policies"},
+	},
+	"auth0_branding": {
+		read:   []string{"read:branding"},
+		create: []string{"update:branding"},
+		update: []string{"update:branding"},
+		delete: []string{"delete:branding"},
+		changed: func(d *schema.ResourceDiff) []string {
+			// The template is deleted when it's removed from an existing
+			// resource.
+			o, n := d.GetChange("universal_login")
+			if d.Id() != "" && len(o.([]interface{})) > 0 && len(n.([]interface{})) == 0 {
+				return []string{"delete:branding"}
+			}
+			return nil
+		},
+	},
+	"auth0_organization": {
+		read:   []string{"read:organizations", "read:organization_connections"},
+		create: []string{"create:organizations", "create:organization_connections"},
+		update: []string{"update:organizations", "create:organization_connections", "update:organization_connections", "delete:organization_connections"},
+		delete: []string{"delete:organizations"},
+	},
+	"auth0_organization_member": {
+		read:   []string{"read:organizations", "read:organization_member_roles"},
+		create: []string{"create:organization_members", "create:organization_member_roles"},
+		update: []string{"create:organization_member_roles", "delete:organization_member_roles"},
+		delete: []string{"delete:organization_members"},
+	},
+	"auth0_action": {
+		read:   []string{"read:actions"},
+		create: []string{"create:actions"},
+		update: []string{"update:actions", "create:actions"},
+		delete: []string{"delete:actions"},
+	},
+	"auth0_trigger_binding":             associationScopes("actions"),
+	"auth0_brute_force_protection":      settingsScopes("attack_protection"),
+	"auth0_suspicious_ip_throttling":    settingsScopes("attack_protection"),
+	"auth0_breached_password_detection": settingsScopes("attack_protection"),
+}
+
+// dataSourceScopes lists the scopes needed by each data source of the
+// provider. Data sources reading through a resource's read function need at
+// least the same scopes.
+var dataSourceScopes = map[string]requiredScopes{
+	"auth0_client":          {read: []string{"read:clients"}},
+	"auth0_resource_server": {read: []string{"read:resource_servers"}},
+	"auth0_connection":      {read: []string{"read:connections"}},
+	"auth0_tenant":          {read: []string{"read:tenant_settings"}},
+	"auth0_users":           {read: []string{"read:users"}},
+	"auth0_role":            {read: []string{"read:roles", "read:users"}},
+	"auth0_signing_keys":    {read: []string{"read:signing_keys"}},
+}
+
+// grantedScopes holds the scopes granted to the access token of each
+// configured Management API client. Clients whose token couldn't be decoded
+// are missing, and no scopes are checked for them.
+var grantedScopes sync.Map
+
+// tokenScopes decodes the scopes granted to a JWT access token, without
+// verifying its signature. It reports false if the token isn't a JWT.
+func tokenScopes(token string) ([]string, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, false
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, false
+	}
+	var claims struct {
+		Scope string `json:"scope"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, false
+	}
+	return strings.Fields(claims.Scope), true
+}
+
+// missingScopes returns the required scopes which were not granted, sorted.
+func missingScopes(granted, required []string) []string {
+	g := make(map[string]bool, len(granted))
+	for _, s := range granted {
+		g[s] = true
+	}
+	var missing []string
+	for _, s := range required {
+		if !g[s] {
+			missing = append(missing, s)
+			g[s] = true
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
+
+// checkScopes fails if the access token of the Management API client lacks
+// any of the required scopes.
+func checkScopes(m interface{}, name string, required ...[]string) error {
+	api, ok := m.(*management.Management)
+	if !ok {
+		return nil
+	}
+	granted, ok := grantedScopes.Load(api)
+	if !ok {
+		return nil
+	}
+
+	var all []string
+	for _, r := range required {
+		all = append(all, r...)
+	}
+	missing := missingScopes(granted.([]string), all)
+	if len(missing) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s needs the following scopes of the Auth0 Management API, which the provider's client was not granted: %s. "+
+		"Grant them in the Auth0 dashboard, under Applications > APIs > Auth0 Management API > Machine to Machine Applications",
+		name, strings.Join(missing, ", "))
+}
+
+// requiresNew reports whether any of the changed keys of the diff is, or is
+// nested in, an attribute forcing the resource to be replaced.
+func requiresNew(s map[string]*schema.Schema, d *schema.ResourceDiff) bool {
+	for _, key := range d.GetChangedKeysPrefix("") {
+		if forcesNew(s, strings.Split(key, ".")) {
+			return true
+		}
+	}
+	return false
+}
+
+func forcesNew(s map[string]*schema.Schema, path []string) bool {
+	v, ok := s[path[0]]
+	if !ok {
+		return false
+	}
+	if v.ForceNew {
+		return true
+	}
+	// Nested keys are prefixed with the index of the list or set element.
+	if r, ok := v.Elem.(*schema.Resource); ok && len(path) > 2 {
+		return forcesNew(r.Schema, path[2:])
+	}
+	return false
+}
+
+// withScopes wraps the operations of a resource so that they fail early,
+// with an actionable error, when the provider's client lacks the scopes they
+// need. Creates, updates and replacements are checked when planning, so that
+// no change is made if any resource can't be managed.
+func withScopes(name string, r *schema.Resource, scopes requiredScopes) {
+	if read := r.Read; read != nil {
+		r.Read = func(d *schema.ResourceData, m interface{}) error {
+			if err := checkScopes(m, name, scopes.read); err != nil {
+				return err
+			}
+			return read(d, m)
+		}
+	}
+
+	if del := r.Delete; del != nil {
+		r.Delete = func(d *schema.ResourceData, m interface{}) error {
+			if err := checkScopes(m, name, scopes.delete); err != nil {
+				return err
+			}
+			return del(d, m)
+		}
+	}
+
+	// Data sources have no diff to customize.
+	if r.Create == nil {
+		return
+	}
+
+	customizeDiff := r.CustomizeDiff
+	r.CustomizeDiff = func(d *schema.ResourceDiff, m interface{}) error {
+		var changed []string
+		if scopes.changed != nil {
+			changed = scopes.changed(d)
+		}
+
+		var err error
+		switch {
+		case d.Id() == "":
+			err = checkScopes(m, name, scopes.read, scopes.create, changed)
+		case requiresNew(r.Schema, d):
+			err = checkScopes(m, name, scopes.read, scopes.create, scopes.delete, changed)
+		case len(d.GetChangedKeysPrefix("")) > 0:
+			err = checkScopes(m, name, scopes.read, scopes.update, changed)
+		}
+		if err != nil {
+			return err
+		}
+		if customizeDiff != nil {
+			return customizeDiff(d, m)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,254 @@
+package auth0
+
+import (
+	"encoding/base64"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"gopkg.in/auth0.v5/management"
+)
+
+func testToken(payload string) string {
+	return "eyJhbGciOiJSUzI1NiJ9." + base64.RawURLEncoding.EncodeToString([]byte(payload)) + ".signature"
+}
+
+func TestTokenScopes(t *testing.T) {
+	for _, test := range []struct {
+		token  string
+		scopes []string
+		ok     bool
+	}{
+		{testToken(`{"scope":"read:clients update:clients"}`), []string{"read:clients", "update:clients"}, true},
+		{testToken(`{"sub":"abc123@clients"}`), []string{}, true},
+		{testToken(`not json`), nil, false},
+		{"opaque-token", nil, false},
+	} {
+		scopes, ok := tokenScopes(test.token)
+		if ok != test.ok {
+			t.Errorf("expected ok to be %t for %q, got %t", test.ok, test.token, ok)
+		}
+		if !reflect.DeepEqual(scopes, test.scopes) {
+			t.Errorf("expected scopes %v for %q, got %v", test.scopes, test.token, scopes)
+		}
+	}
+}
+
+func TestMissingScopes(t *testing.T) {
+	missing := missingScopes(
+		[]string{"read:clients", "update:clients"},
+		[]string{"update:clients", "delete:clients", "read:clients", "create:clients", "delete:clients"})
+	expected := []string{"create:clients", "delete:clients"}
+	if !reflect.DeepEqual(missing, expected) {
+		t.Errorf("expected missing scopes %v, got %v", expected, missing)
+	}
+}
+
+func TestCheckScopes(t *testing.T) {
+	api, err := management.New("example.auth0.com", management.WithStaticToken("token"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := checkScopes(api, "auth0_tenant", []string{"update:tenant_settings"}); err != nil {
+		t.Errorf("expected no error when the scopes are unknown, got %v", err)
+	}
+
+	grantedScopes.Store(api, []string{"read:tenant_settings"})
+	defer grantedScopes.Delete(api)
+
+	if err := checkScopes(api, "auth0_tenant", []string{"read:tenant_settings"}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	err = checkScopes(api, "auth0_tenant", []string{"read:tenant_settings"}, []string{"update:tenant_settings"})
+	if err == nil || !strings.Contains(err.Error(), "auth0_tenant needs the following scopes of the Auth0 Management API, which the provider's client was not granted: update:tenant_settings") {
+		t.Errorf("expected an error listing the missing scope, got %v", err)
+	}
+}
+
+func TestScopesCoverProvider(t *testing.T) {
+	p := Provider()
+	for name := range p.ResourcesMap {
+		if _, ok := resourceScopes[name]; !ok {
+			t.Errorf("no scopes defined for resource %s", name)
+		}
+	}
+	for name := range resourceScopes {
+		if _, ok := p.ResourcesMap[name]; !ok {
+			t.Errorf("scopes defined for unknown resource %s", name)
+		}
+	}
+	for name := range p.DataSourcesMap {
+		if _, ok := dataSourceScopes[name]; !ok {
+			t.Errorf("no scopes defined for data source %s", name)
+		}
+	}
+	for name := range dataSourceScopes {
+		if _, ok := p.DataSourcesMap[name]; !ok {
+			t.Errorf("scopes defined for unknown data source %s", name)
+		}
+	}
+}
+
+func TestDataSourceScopesCoverResourceReads(t *testing.T) {
+	// These data sources read through the read functions of the given
+	// resources.
+	for dataSource, resource := range map[string]string{
+		"auth0_client":          "auth0_client",
+		"auth0_resource_server": "auth0_resource_server",
+		"auth0_connection":      "auth0_connection",
+		"auth0_tenant":          "auth0_tenant",
+		"auth0_role":            "auth0_role",
+	} {
+		missing := missingScopes(dataSourceScopes[dataSource].read, resourceScopes[resource].read)
+		if len(missing) > 0 {
+			t.Errorf("data source %s lacks scopes read by resource %s: %v", dataSource, resource, missing)
+		}
+	}
+}
+
+func TestWithScopesChecksReplacement(t *testing.T) {
+	api, err := management.New("example.auth0.com", management.WithStaticToken("token"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	grantedScopes.Store(api, []string{"read:things", "create:things", "update:things"})
+	defer grantedScopes.Delete(api)
+
+	noop := func(d *schema.ResourceData, m interface{}) error { return nil }
+	r := &schema.Resource{
+		Create: noop,
+		Read:   noop,
+		Update: noop,
+		Delete: noop,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+	withScopes("auth0_thing", r, managedScopes("things"))
+
+	state := &terraform.InstanceState{
+		ID: "thing",
+		Attributes: map[string]string{
+			"id":               "thing",
+			"name":             "thing",
+			"description":      "a thing",
+			"options.#":        "1",
+			"options.0.region": "eu",
+		},
+	}
+
+	for _, test := range []struct {
+		config  map[string]interface{}
+		missing bool
+	}{
+		{map[string]interface{}{
+			"name":        "thing",
+			"description": "another thing",
+			"options":     []interface{}{map[string]interface{}{"region": "eu"}},
+		}, false},
+		{map[string]interface{}{
+			"name":        "another thing",
+			"description": "a thing",
+			"options":     []interface{}{map[string]interface{}{"region": "eu"}},
+		}, true},
+		{map[string]interface{}{
+			"name":        "thing",
+			"description": "a thing",
+			"options":     []interface{}{map[string]interface{}{"region": "us"}},
+		}, true},
+	} {
+		_, err := r.Diff(state, terraform.NewResourceConfigRaw(test.config), api)
+		if test.missing && (err == nil || !strings.Contains(err.Error(), "delete:things")) {
+			t.Errorf("expected an error listing delete:things for %v, got %v", test.config, err)
+		}
+		if !test.missing && err != nil {
+			t.Errorf("unexpected error for %v: %v", test.config, err)
+		}
+	}
+}
+
+func TestWithScopesChecksChangedScopes(t *testing.T) {
+	api, err := management.New("example.auth0.com", management.WithStaticToken("token"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	grantedScopes.Store(api, []string{"read:things", "update:things"})
+	defer grantedScopes.Delete(api)
+
+	noop := func(d *schema.ResourceData, m interface{}) error { return nil }
+	r := &schema.Resource{
+		Create: noop,
+		Read:   noop,
+		Update: noop,
+		Delete: noop,
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"rotation_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+	scopes := settingsScopes("things")
+	scopes.changed = func(d *schema.ResourceDiff) []string {
+		if d.HasChange("rotation_trigger") {
+			return []string{"rotate:things"}
+		}
+		return nil
+	}
+	withScopes("auth0_thing", r, scopes)
+
+	state := &terraform.InstanceState{
+		ID: "thing",
+		Attributes: map[string]string{
+			"id":               "thing",
+			"description":      "a thing",
+			"rotation_trigger": "1",
+		},
+	}
+
+	_, err = r.Diff(state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"description":      "another thing",
+		"rotation_trigger": "1",
+	}), api)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	_, err = r.Diff(state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"description":      "a thing",
+		"rotation_trigger": "2",
+	}), api)
+	if err == nil || !strings.Contains(err.Error(), "rotate:things") {
+		t.Errorf("expected an error listing rotate:things, got %v", err)
+	}
+}
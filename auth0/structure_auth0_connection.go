@@ -267,10 +267,13 @@ func expandConnection(d ResourceData) *management.Connection {
 		Name:               String(d, "name", IsNewResource()),
 		Strategy:           String(d, "strategy", IsNewResource()),
 		IsDomainConnection: Bool(d, "is_domain_connection"),
-		EnabledClients:     Set(d, "enabled_clients").List(),
 		Realms:             Slice(d, "realms", IsNewResource(), HasChange()),
 	}
 
+	// Enabled clients are only sent when they change, so that clients enabled
+	// with auth0_connection_client aren't overwritten by unrelated updates.
+	c.EnabledClients = Set(d, "enabled_clients", IsNewResource(), HasChange()).List()
+
 	s := d.Get("strategy").(string)
 
 	List(d, "options").Elem(func(d ResourceData) {